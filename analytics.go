@@ -0,0 +1,451 @@
+package main
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Minute samples older than this are merged into hourly ones
+const populationRawRetention = 7 * 24 * time.Hour
+const populationSaveInterval = 5 * time.Minute
+
+const (
+	PopulationResolutionHourly = "hourly"
+	PopulationResolutionDaily  = "daily"
+	PopulationResolutionWeekly = "weekly"
+)
+
+// PopulationPoint aggregates the online player count over a minute or an hour
+type PopulationPoint struct {
+	Time    int64 `json:"t"` // Start of the minute or hour, unix timestamp
+	Online  int   `json:"o"` // Last sampled count
+	Peak    int   `json:"p"`
+	Sum     int   `json:"s"` // Sum of the sampled counts, for averages
+	Samples int   `json:"n"`
+}
+
+type PlayerSession struct {
+	Name  string `json:"name"`
+	Start int64  `json:"start"` // unix timestamp
+	End   int64  `json:"end"`   // unix timestamp
+}
+
+// PopulationStore is the on-disk time-series of a server
+type PopulationStore struct {
+	Minutes      []PopulationPoint `json:"minutes"`
+	Hours        []PopulationPoint `json:"hours"`
+	Sessions     []PlayerSession   `json:"sessions"`
+	OpenSessions map[string]int64  `json:"openSessions"` // Player name to session start
+	FirstSeen    map[string]int64  `json:"firstSeen"`    // Player name to first time seen online
+}
+
+type PopulationBucket struct {
+	Start                 int64   `json:"start"` // unix timestamp
+	AverageOnline         float64 `json:"averageOnline"`
+	PeakOnline            int     `json:"peakOnline"`
+	UniquePlayers         int     `json:"uniquePlayers"`
+	NewPlayers            int     `json:"newPlayers"`
+	Sessions              int     `json:"sessions"` // Sessions started in the bucket
+	AverageSessionSeconds float64 `json:"averageSessionSeconds"`
+}
+
+type PopulationSummary struct {
+	From                  int64   `json:"from"` // unix timestamp
+	To                    int64   `json:"to"`   // unix timestamp
+	PeakOnline            int     `json:"peakOnline"`
+	PeakTime              int64   `json:"peakTime"` // unix timestamp
+	AverageOnline         float64 `json:"averageOnline"`
+	UniquePlayers         int     `json:"uniquePlayers"`
+	NewPlayers            int     `json:"newPlayers"`
+	ReturningNewPlayers   int     `json:"returningNewPlayers"` // New players that came back on a later day
+	Retention             float64 `json:"retention"`           // 0-1, returning new players / new players
+	Sessions              int     `json:"sessions"`
+	AverageSessionSeconds float64 `json:"averageSessionSeconds"`
+}
+
+var (
+	populationMutex    sync.Mutex
+	population         *PopulationStore
+	populationPath     string
+	populationLastSave time.Time
+)
+
+func new_population_store() *PopulationStore {
+	return &PopulationStore{
+		Minutes:      []PopulationPoint{},
+		Hours:        []PopulationPoint{},
+		Sessions:     []PlayerSession{},
+		OpenSessions: make(map[string]int64),
+		FirstSeen:    make(map[string]int64),
+	}
+}
+
+// population_init loads the time-series of the connected server
+func population_init() error {
+	populationMutex.Lock()
+	defer populationMutex.Unlock()
+
+	population = new_population_store()
+	populationPath = filepath.Join(get_server_folder(), "population.json")
+	populationLastSave = time.Now()
+
+	if !file_exists(populationPath) {
+		return nil
+	}
+
+	err := readJSON(populationPath, population)
+	if err != nil {
+		population = new_population_store()
+		return err
+	}
+	if population.OpenSessions == nil {
+		population.OpenSessions = make(map[string]int64)
+	}
+	if population.FirstSeen == nil {
+		population.FirstSeen = make(map[string]int64)
+	}
+
+	// Sessions left open by a crash end at the last sample
+	lastSample := int64(0)
+	if len(population.Minutes) > 0 {
+		lastSample = population.Minutes[len(population.Minutes)-1].Time + 60
+	}
+	population_close_sessions(lastSample)
+
+	return nil
+}
+
+// population_record samples the online players, called on every players update
+func population_record(onlinePlayers map[string]bool) {
+	populationMutex.Lock()
+	defer populationMutex.Unlock()
+
+	if population == nil {
+		return
+	}
+
+	now := time.Now().Unix()
+	minute := now - now%60
+	online := len(onlinePlayers)
+
+	if last := len(population.Minutes) - 1; last >= 0 && population.Minutes[last].Time == minute {
+		point := &population.Minutes[last]
+		point.Online = online
+		point.Peak = max(point.Peak, online)
+		point.Sum += online
+		point.Samples++
+	} else {
+		population.Minutes = append(population.Minutes, PopulationPoint{
+			Time:    minute,
+			Online:  online,
+			Peak:    online,
+			Sum:     online,
+			Samples: 1,
+		})
+	}
+
+	for name := range onlinePlayers {
+		if _, ok := population.OpenSessions[name]; !ok {
+			population.OpenSessions[name] = now
+		}
+		if _, ok := population.FirstSeen[name]; !ok {
+			population.FirstSeen[name] = now
+		}
+	}
+
+	for name, start := range population.OpenSessions {
+		if !onlinePlayers[name] {
+			population.Sessions = append(population.Sessions, PlayerSession{Name: name, Start: start, End: now})
+			delete(population.OpenSessions, name)
+		}
+	}
+
+	if time.Since(populationLastSave) >= populationSaveInterval {
+		err := population_save_locked()
+		if err != nil {
+			runtime.LogError(appContext, "Error saving population: "+err.Error())
+		}
+	}
+}
+
+// population_close_sessions ends all open sessions, must be called with populationMutex held
+func population_close_sessions(end int64) {
+	for name, start := range population.OpenSessions {
+		population.Sessions = append(population.Sessions, PlayerSession{Name: name, Start: start, End: max(start, end)})
+		delete(population.OpenSessions, name)
+	}
+}
+
+// population_close ends the open sessions and saves the time-series when the connection ends
+func population_close() error {
+	populationMutex.Lock()
+	defer populationMutex.Unlock()
+
+	if population == nil {
+		return nil
+	}
+
+	population_close_sessions(time.Now().Unix())
+	err := population_save_locked()
+	population = nil
+
+	return err
+}
+
+// population_compact merges old minute samples into hourly ones and drops data
+// past the retention period, must be called with populationMutex held
+func population_compact() {
+	now := time.Now()
+	rawCutoff := now.Add(-populationRawRetention).Unix()
+
+	keep := 0
+	for _, point := range population.Minutes {
+		if point.Time >= rawCutoff {
+			population.Minutes[keep] = point
+			keep++
+			continue
+		}
+
+		hour := point.Time - point.Time%3600
+		if last := len(population.Hours) - 1; last >= 0 && population.Hours[last].Time == hour {
+			merged := &population.Hours[last]
+			merged.Online = point.Online
+			merged.Peak = max(merged.Peak, point.Peak)
+			merged.Sum += point.Sum
+			merged.Samples += point.Samples
+		} else {
+			point.Time = hour
+			population.Hours = append(population.Hours, point)
+		}
+	}
+	population.Minutes = population.Minutes[:keep]
+
+	if *config.AnalyticsRetentionDays <= 0 {
+		return
+	}
+	cutoff := now.AddDate(0, 0, -*config.AnalyticsRetentionDays).Unix()
+
+	keep = 0
+	for _, point := range population.Hours {
+		if point.Time >= cutoff {
+			population.Hours[keep] = point
+			keep++
+		}
+	}
+	population.Hours = population.Hours[:keep]
+
+	keep = 0
+	for _, session := range population.Sessions {
+		if session.End >= cutoff {
+			population.Sessions[keep] = session
+			keep++
+		}
+	}
+	population.Sessions = population.Sessions[:keep]
+}
+
+// population_save_locked must be called with populationMutex held
+func population_save_locked() error {
+	population_compact()
+	populationLastSave = time.Now()
+
+	err := create_folder(filepath.Dir(populationPath))
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(populationPath, population)
+}
+
+// bucket_start returns the start of the local hour, day or week containing t
+func bucket_start(t time.Time, resolution string) time.Time {
+	switch resolution {
+	case PopulationResolutionDaily:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	case PopulationResolutionWeekly:
+		weekday := (int(t.Weekday()) + 6) % 7 // Weeks start on monday
+		return time.Date(t.Year(), t.Month(), t.Day()-weekday, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	}
+}
+
+func bucket_next(start time.Time, resolution string) time.Time {
+	switch resolution {
+	case PopulationResolutionDaily:
+		return start.AddDate(0, 0, 1)
+	case PopulationResolutionWeekly:
+		return start.AddDate(0, 0, 7)
+	default:
+		return start.Add(time.Hour)
+	}
+}
+
+// population_sessions returns the closed sessions together with the currently open ones,
+// must be called with populationMutex held
+func population_sessions() []PlayerSession {
+	now := time.Now().Unix()
+	sessions := make([]PlayerSession, 0, len(population.Sessions)+len(population.OpenSessions))
+	sessions = append(sessions, population.Sessions...)
+	for name, start := range population.OpenSessions {
+		sessions = append(sessions, PlayerSession{Name: name, Start: start, End: now})
+	}
+
+	return sessions
+}
+
+// GetPopulationSeries aggregates the population of the last days of the connected
+// server into hourly, daily or weekly buckets
+func (app *App) GetPopulationSeries(resolution string, days int) []PopulationBucket {
+	populationMutex.Lock()
+	defer populationMutex.Unlock()
+
+	if population == nil || days <= 0 {
+		return []PopulationBucket{}
+	}
+
+	now := time.Now()
+	from := bucket_start(now.AddDate(0, 0, -days), resolution)
+
+	buckets := []PopulationBucket{}
+	index := make(map[int64]int)
+	for start := from; start.Before(now); start = bucket_next(start, resolution) {
+		index[start.Unix()] = len(buckets)
+		buckets = append(buckets, PopulationBucket{Start: start.Unix()})
+	}
+
+	bucket_of := func(timestamp int64) *PopulationBucket {
+		i, ok := index[bucket_start(time.Unix(timestamp, 0), resolution).Unix()]
+		if !ok {
+			return nil
+		}
+		return &buckets[i]
+	}
+
+	sums := make([]int, len(buckets))
+	samples := make([]int, len(buckets))
+	for _, points := range [][]PopulationPoint{population.Hours, population.Minutes} {
+		for _, point := range points {
+			bucket := bucket_of(point.Time)
+			if bucket == nil {
+				continue
+			}
+			i := index[bucket.Start]
+			bucket.PeakOnline = max(bucket.PeakOnline, point.Peak)
+			sums[i] += point.Sum
+			samples[i] += point.Samples
+		}
+	}
+
+	unique := make([]map[string]bool, len(buckets))
+	sessionSeconds := make([]int64, len(buckets))
+	for _, session := range population_sessions() {
+		for start := bucket_start(time.Unix(session.Start, 0), resolution); start.Unix() <= session.End; start = bucket_next(start, resolution) {
+			i, ok := index[start.Unix()]
+			if !ok {
+				continue
+			}
+			if unique[i] == nil {
+				unique[i] = make(map[string]bool)
+			}
+			unique[i][session.Name] = true
+		}
+
+		if bucket := bucket_of(session.Start); bucket != nil {
+			bucket.Sessions++
+			sessionSeconds[index[bucket.Start]] += session.End - session.Start
+		}
+	}
+
+	for _, firstSeen := range population.FirstSeen {
+		if bucket := bucket_of(firstSeen); bucket != nil {
+			bucket.NewPlayers++
+		}
+	}
+
+	for i := range buckets {
+		if samples[i] > 0 {
+			buckets[i].AverageOnline = float64(sums[i]) / float64(samples[i])
+		}
+		if buckets[i].Sessions > 0 {
+			buckets[i].AverageSessionSeconds = float64(sessionSeconds[i]) / float64(buckets[i].Sessions)
+		}
+		buckets[i].UniquePlayers = len(unique[i])
+	}
+
+	return buckets
+}
+
+// GetPopulationSummary returns the peak, averages and new player retention of the
+// last days of the connected server
+func (app *App) GetPopulationSummary(days int) PopulationSummary {
+	populationMutex.Lock()
+	defer populationMutex.Unlock()
+
+	now := time.Now()
+	summary := PopulationSummary{
+		From: now.AddDate(0, 0, -days).Unix(),
+		To:   now.Unix(),
+	}
+
+	if population == nil || days <= 0 {
+		return summary
+	}
+
+	sum, samples := 0, 0
+	for _, points := range [][]PopulationPoint{population.Hours, population.Minutes} {
+		for _, point := range points {
+			if point.Time < summary.From {
+				continue
+			}
+			if point.Peak > summary.PeakOnline {
+				summary.PeakOnline = point.Peak
+				summary.PeakTime = point.Time
+			}
+			sum += point.Sum
+			samples += point.Samples
+		}
+	}
+	if samples > 0 {
+		summary.AverageOnline = float64(sum) / float64(samples)
+	}
+
+	unique := make(map[string]bool)
+	returned := make(map[string]bool)
+	var sessionSeconds int64
+	for _, session := range population_sessions() {
+		if session.End < summary.From {
+			continue
+		}
+		unique[session.Name] = true
+
+		if session.Start >= summary.From {
+			summary.Sessions++
+			sessionSeconds += session.End - session.Start
+		}
+
+		firstSeen, ok := population.FirstSeen[session.Name]
+		if ok && firstSeen >= summary.From && bucket_start(time.Unix(session.Start, 0), PopulationResolutionDaily).After(time.Unix(firstSeen, 0)) {
+			returned[session.Name] = true
+		}
+	}
+	summary.UniquePlayers = len(unique)
+	if summary.Sessions > 0 {
+		summary.AverageSessionSeconds = float64(sessionSeconds) / float64(summary.Sessions)
+	}
+
+	for _, firstSeen := range population.FirstSeen {
+		if firstSeen >= summary.From {
+			summary.NewPlayers++
+		}
+	}
+	summary.ReturningNewPlayers = len(returned)
+	if summary.NewPlayers > 0 {
+		summary.Retention = float64(summary.ReturningNewPlayers) / float64(summary.NewPlayers)
+	}
+
+	return summary
+}
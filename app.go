@@ -72,6 +72,21 @@ func (a *App) startup(ctx context.Context) {
 
 	// Initiate notifications for Windows
 	notification_init()
+
+	// Load the kit library
+	err = kits_init()
+	if err != nil {
+		runtime.LogError(appContext, err.Error())
+	}
+
+	// Load the item and vehicle catalogs
+	err = catalog_init()
+	if err != nil {
+		runtime.LogError(appContext, err.Error())
+	}
+
+	// Start the metrics server if enabled
+	metrics_init()
 }
 
 // domReady is called after front-end resources have been loaded
@@ -20,6 +20,9 @@ var externalFolder string
 var configPath string
 var appIconPath string
 var credentialsPath string
+var credentialsKeyPath string
+var vaultPath string
+var kitsPath string
 
 func path_init() error {
 	appData, err := os.UserConfigDir()
@@ -41,6 +44,9 @@ func path_init() error {
 	configPath = filepath.Join(appFolder, "config.json")
 	appIconPath = filepath.Join(appFolder, "appicon.ico")
 	credentialsPath = filepath.Join(appFolder, "credentials.json")
+	credentialsKeyPath = filepath.Join(appFolder, "credentials.key")
+	vaultPath = filepath.Join(appFolder, "vault.json")
+	kitsPath = filepath.Join(appFolder, "kits.json")
 
 	runtime.LogTrace(appContext, "Attempting to create folders")
 	err = create_folder(appFolder)
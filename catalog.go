@@ -0,0 +1,438 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// Built by frontend/scripts/items.mjs and frontend/scripts/vehicles.mjs
+//
+//go:embed frontend/src/assets/items.json
+var itemsCatalogJSON []byte
+
+//go:embed frontend/src/assets/vehicles.json
+var vehiclesCatalogJSON []byte
+
+//go:embed frontend/game-translations/*.txt
+var gameTranslationsFS embed.FS
+
+const defaultCatalogPageSize = 50
+const maxCatalogPageSize = 500
+
+// Encodings of the translation files that aren't UTF-8, as exported by the game
+var gameTranslationEncodings = map[string]encoding.Encoding{
+	"ru-RU": charmap.Windows1251,
+	"tr-TR": charmap.Windows1254,
+	"uk-UA": charmap.Windows1251,
+}
+
+var gameTranslationRegex = regexp.MustCompile(`ItemName_([\w.]+\.[\w.]+)\s*=\s*"(.*)"`)
+
+type CatalogEntry struct {
+	Id       string   `json:"id"`
+	Name     string   `json:"name"` // Localized name if known, English name otherwise
+	Category string   `json:"category"`
+	Model    string   `json:"model,omitempty"` // Vehicle model, empty for items
+	Images   []string `json:"images"`
+}
+
+type CatalogQuery struct {
+	Query    string `json:"query"`
+	Category string `json:"category"` // Empty for all categories
+	Locale   string `json:"locale"`   // Empty for the app language
+	Page     int    `json:"page"`     // Starts from 0
+	PageSize int    `json:"pageSize"` // 0 for the default
+}
+
+type CatalogPage struct {
+	Entries  []CatalogEntry `json:"entries"`
+	Total    int            `json:"total"`
+	Page     int            `json:"page"`
+	PageSize int            `json:"pageSize"`
+}
+
+type catalog struct {
+	entries    []CatalogEntry
+	index      map[string]int // lowercase ID to entry
+	categories []string
+	modules    map[string]bool // lowercase module names
+}
+
+// The catalogs of the game, and the catalogs searched with the mod catalog of the server on top
+var baseItemCatalog, baseVehicleCatalog catalog
+var itemCatalog, vehicleCatalog catalog
+
+// gameTranslations maps each locale to item names by ID, catalogTranslations adds the mod translations
+var gameTranslations map[string]map[string]string
+var catalogTranslations map[string]map[string]string
+
+// catalogMutex guards the searched catalogs and translations, they are replaced from
+// other goroutines when the mod catalog changes
+var catalogMutex sync.RWMutex
+
+func catalog_init() error {
+	baseItemCatalog, baseVehicleCatalog = catalog{}, catalog{}
+	gameTranslations = map[string]map[string]string{}
+
+	var itemCategories []struct {
+		Name  string `json:"name"`
+		Items []struct {
+			Name   string   `json:"name"`
+			ItemId string   `json:"itemId"`
+			Images []string `json:"images"`
+		} `json:"items"`
+	}
+	err := json.Unmarshal(itemsCatalogJSON, &itemCategories)
+	if err != nil {
+		return errors.New("Error reading item catalog: " + err.Error())
+	}
+
+	for _, category := range itemCategories {
+		for _, item := range category.Items {
+			baseItemCatalog.add(CatalogEntry{Id: item.ItemId, Name: item.Name, Category: category.Name, Images: item.Images})
+		}
+	}
+
+	var vehicleCategories []vehicleCatalogNode
+	err = json.Unmarshal(vehiclesCatalogJSON, &vehicleCategories)
+	if err != nil {
+		return errors.New("Error reading vehicle catalog: " + err.Error())
+	}
+
+	for _, category := range vehicleCategories {
+		category.walk(category.Name, "", &baseVehicleCatalog)
+	}
+
+	files, err := gameTranslationsFS.ReadDir("frontend/game-translations")
+	if err != nil {
+		return errors.New("Error reading game translations: " + err.Error())
+	}
+
+	for _, file := range files {
+		locale := strings.TrimSuffix(file.Name(), ".txt")
+		content, err := gameTranslationsFS.ReadFile(path.Join("frontend/game-translations", file.Name()))
+		if err != nil {
+			return errors.New("Error reading game translations: " + err.Error())
+		}
+
+		translations, err := parse_game_translations(content, gameTranslationEncodings[locale])
+		if err != nil {
+			return errors.New("Error parsing " + file.Name() + ": " + err.Error())
+		}
+		gameTranslations[locale] = translations
+	}
+
+	// Items missing from the item list still name their module in the translations
+	for id := range gameTranslations["en-US"] {
+		if module, _, ok := strings.Cut(strings.ToLower(id), "."); ok && baseItemCatalog.modules != nil {
+			baseItemCatalog.modules[module] = true
+		}
+	}
+
+	runtime.LogInfof(appContext, "Loaded %d items, %d vehicles and %d game translations", len(baseItemCatalog.entries), len(baseVehicleCatalog.entries), len(gameTranslations))
+	catalog_apply_overlay(nil, nil, nil, nil)
+
+	return nil
+}
+
+// catalog_apply_overlay rebuilds the searched catalogs from the game catalogs and the mod
+// entries, entries with an ID already in the game catalogs are ignored. The modules of
+// the mods count as known even for IDs missing from the entries
+func catalog_apply_overlay(modItems []CatalogEntry, modVehicles []CatalogEntry, modModules []string, modTranslations map[string]map[string]string) {
+	items, vehicles := baseItemCatalog.clone(), baseVehicleCatalog.clone()
+	for _, entry := range modItems {
+		items.add(entry)
+	}
+	for _, entry := range modVehicles {
+		vehicles.add(entry)
+	}
+	for _, module := range modModules {
+		items.modules[strings.ToLower(module)] = true
+		vehicles.modules[strings.ToLower(module)] = true
+	}
+
+	translations := map[string]map[string]string{}
+	for locale, names := range gameTranslations {
+		translations[locale] = maps.Clone(names)
+	}
+	for locale, names := range modTranslations {
+		if translations[locale] == nil {
+			translations[locale] = map[string]string{}
+		}
+		maps.Copy(translations[locale], names)
+	}
+
+	// The catalogs are built before locking so searches only wait for the swap
+	catalogMutex.Lock()
+	defer catalogMutex.Unlock()
+
+	itemCatalog, vehicleCatalog, catalogTranslations = items, vehicles, translations
+}
+
+// vehicleCatalogNode is a category, model or type of vehicles.json, models with a single
+// type are merged into a type
+type vehicleCatalogNode struct {
+	Name     string               `json:"name"`
+	Type     string               `json:"type"`
+	Id       string               `json:"id"`
+	Images   []string             `json:"images"`
+	Children []vehicleCatalogNode `json:"children"`
+}
+
+func (node vehicleCatalogNode) walk(category string, model string, c *catalog) {
+	if node.Type == "model" || model == "" && node.Type == "type" {
+		model = node.Name
+	}
+
+	if node.Id != "" {
+		name := node.Name
+		if model != "" && model != node.Name {
+			name = model + " - " + node.Name
+		}
+		c.add(CatalogEntry{Id: node.Id, Name: name, Category: category, Model: model, Images: node.Images})
+	}
+
+	for _, child := range node.Children {
+		child.walk(category, model, c)
+	}
+}
+
+func (c *catalog) add(entry CatalogEntry) {
+	if c.index == nil {
+		c.index = map[string]int{}
+		c.modules = map[string]bool{}
+	}
+	if entry.Images == nil {
+		entry.Images = []string{}
+	}
+
+	id := strings.ToLower(entry.Id)
+	if _, ok := c.index[id]; ok {
+		return
+	}
+	c.index[id] = len(c.entries)
+	c.entries = append(c.entries, entry)
+	if module, _, ok := strings.Cut(id, "."); ok {
+		c.modules[module] = true
+	}
+
+	if !slices.Contains(c.categories, entry.Category) {
+		c.categories = append(c.categories, entry.Category)
+	}
+}
+
+func (c catalog) clone() catalog {
+	clone := catalog{
+		entries:    slices.Clone(c.entries),
+		index:      maps.Clone(c.index),
+		categories: slices.Clone(c.categories),
+		modules:    maps.Clone(c.modules),
+	}
+	if clone.index == nil {
+		clone.index = map[string]int{}
+		clone.modules = map[string]bool{}
+	}
+
+	return clone
+}
+
+func (c *catalog) find(id string) *CatalogEntry {
+	if i, ok := c.index[strings.ToLower(strings.TrimSpace(id))]; ok {
+		return &c.entries[i]
+	}
+
+	return nil
+}
+
+// decode_translation converts a translation file to UTF-8, the encoding is only used
+// for files that aren't UTF-8 already
+func decode_translation(content []byte, enc encoding.Encoding) ([]byte, error) {
+	if enc == nil || utf8.Valid(content) {
+		return content, nil
+	}
+
+	return enc.NewDecoder().Bytes(content)
+}
+
+// parse_game_translations reads the ItemName_ entries of a game translation file
+func parse_game_translations(content []byte, enc encoding.Encoding) (map[string]string, error) {
+	content, err := decode_translation(content, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	translations := map[string]string{}
+	for _, match := range gameTranslationRegex.FindAllSubmatch(content, -1) {
+		translations[string(match[1])] = string(match[2])
+	}
+
+	return translations, nil
+}
+
+// catalog_locale returns the locale of the query, or the app language if it has game translations,
+// must be called with catalogMutex held
+func catalog_locale(locale string) string {
+	if locale == "" && config.Language != nil {
+		locale = *config.Language
+	}
+	if _, ok := catalogTranslations[locale]; ok {
+		return locale
+	}
+
+	return "en-US"
+}
+
+// fuzzy_score rates how well the term matches the text, -1 if it doesn't match. Exact
+// matches rank above prefixes, prefixes above substrings and substrings above subsequences
+func fuzzy_score(term string, text string) int {
+	text = strings.ToLower(text)
+	if term == "" || text == "" {
+		return -1
+	}
+
+	switch {
+	case text == term:
+		return 1000
+	case strings.HasPrefix(text, term):
+		return 800 - min(len(text)-len(term), 100)
+	}
+
+	if index := strings.Index(text, term); index >= 0 {
+		score := 600 - min(index, 100)
+		// Prefer matches at the start of a word
+		if r, _ := utf8.DecodeLastRuneInString(text[:index]); strings.ContainsRune(" ._-/()", r) {
+			score += 100
+		}
+		return score
+	}
+
+	// Every character of the term in order, with a penalty for the gaps between them
+	gaps, last := 0, -1
+	remaining := text
+	offset := 0
+	for _, r := range term {
+		index := strings.IndexRune(remaining, r)
+		if index < 0 {
+			return -1
+		}
+		if last >= 0 {
+			gaps += offset + index - last - 1
+		}
+		last = offset + index
+		offset += index + utf8.RuneLen(r)
+		remaining = remaining[index+utf8.RuneLen(r):]
+	}
+
+	return max(1, 300-gaps*10)
+}
+
+// catalog_entry_score matches each term of the query against the ID, the ID without the
+// module and the names of the entry, all terms have to match
+func catalog_entry_score(terms []string, entry CatalogEntry, localizedName string) int {
+	fields := []string{entry.Id, entry.Name, localizedName}
+	if _, id, ok := strings.Cut(entry.Id, "."); ok {
+		fields = append(fields, id)
+	}
+
+	total := 0
+	for _, term := range terms {
+		best := -1
+		for _, field := range fields {
+			best = max(best, fuzzy_score(term, field))
+		}
+		if best < 0 {
+			return -1
+		}
+		total += best
+	}
+
+	return total
+}
+
+func (c *catalog) search(query CatalogQuery, translations map[string]string) CatalogPage {
+	if query.PageSize <= 0 {
+		query.PageSize = defaultCatalogPageSize
+	}
+	query.PageSize = min(query.PageSize, maxCatalogPageSize)
+	query.Page = max(0, query.Page)
+
+	terms := strings.Fields(strings.ToLower(query.Query))
+
+	type scoredEntry struct {
+		entry CatalogEntry
+		score int
+	}
+	matches := []scoredEntry{}
+	for _, entry := range c.entries {
+		if query.Category != "" && !strings.EqualFold(entry.Category, query.Category) {
+			continue
+		}
+
+		score := 0
+		if len(terms) > 0 {
+			score = catalog_entry_score(terms, entry, translations[entry.Id])
+			if score < 0 {
+				continue
+			}
+		}
+
+		if localizedName, ok := translations[entry.Id]; ok {
+			entry.Name = localizedName
+		}
+		matches = append(matches, scoredEntry{entry, score})
+	}
+
+	// Stable so entries with the same score keep the catalog order
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].score > matches[j].score })
+
+	page := CatalogPage{Entries: []CatalogEntry{}, Total: len(matches), Page: query.Page, PageSize: query.PageSize}
+	start := query.Page * query.PageSize
+	for i := start; i < len(matches) && i < start+query.PageSize; i++ {
+		page.Entries = append(page.Entries, matches[i].entry)
+	}
+
+	return page
+}
+
+// SearchItems fuzzy searches the item catalog by ID and English or localized name
+func (app *App) SearchItems(query CatalogQuery) CatalogPage {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return itemCatalog.search(query, catalogTranslations[catalog_locale(query.Locale)])
+}
+
+// SearchVehicles fuzzy searches the vehicle catalog by ID and name
+func (app *App) SearchVehicles(query CatalogQuery) CatalogPage {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return vehicleCatalog.search(query, nil)
+}
+
+func (app *App) GetItemCategories() []string {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return itemCatalog.categories
+}
+
+func (app *App) GetVehicleCategories() []string {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return vehicleCatalog.categories
+}
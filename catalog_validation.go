@@ -0,0 +1,198 @@
+package main
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const (
+	CatalogIdKnown         = "known"
+	CatalogIdUnknown       = "unknown"        // The module is known but the ID isn't, likely a typo, or there is no module
+	CatalogIdUnknownModule = "unknown_module" // The module isn't in the game or the imported mods
+	CatalogIdUnlisted      = "unlisted"       // A game ID missing from the bundled catalog, which can lag behind game updates
+)
+
+const baseModule = "Base"
+
+const maxCatalogSuggestions = 3
+
+type CatalogIdCheck struct {
+	Id          string   `json:"id"` // The ID with the casing of the catalog if known
+	Status      string   `json:"status"`
+	Module      string   `json:"module"`
+	Suggestions []string `json:"suggestions"` // Closest known IDs of unknown IDs
+}
+
+// edit_distance is the Levenshtein distance of two strings
+func edit_distance(a string, b string) int {
+	ar, br := []rune(a), []rune(b)
+	previous := make([]int, len(br)+1)
+	current := make([]int, len(br)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		current[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(br)]
+}
+
+// suggestions returns the IDs closest to the ID, compared with and without the module
+func (c *catalog) suggestions(id string) []string {
+	id = strings.ToLower(id)
+	_, name, hasModule := strings.Cut(id, ".")
+	if !hasModule {
+		name = id
+	}
+	limit := max(2, len(name)/3)
+
+	type suggestion struct {
+		id       string
+		distance int
+	}
+	found := []suggestion{}
+	for _, entry := range c.entries {
+		entryId := strings.ToLower(entry.Id)
+		distance := edit_distance(id, entryId)
+		if _, entryName, ok := strings.Cut(entryId, "."); ok {
+			distance = min(distance, edit_distance(name, entryName))
+		}
+		if distance <= limit {
+			found = append(found, suggestion{entry.Id, distance})
+		}
+	}
+
+	slices.SortStableFunc(found, func(a, b suggestion) int { return a.distance - b.distance })
+
+	suggestions := []string{}
+	for i := 0; i < len(found) && i < maxCatalogSuggestions; i++ {
+		suggestions = append(suggestions, found[i].id)
+	}
+
+	return suggestions
+}
+
+// check checks an ID against the catalog, IDs of other known sources count as known
+func (c *catalog) check(id string, known map[string]string) CatalogIdCheck {
+	id = strings.TrimSpace(id)
+	result := CatalogIdCheck{Id: id, Status: CatalogIdKnown, Suggestions: []string{}}
+
+	if entry := c.find(id); entry != nil {
+		result.Id = entry.Id
+	} else if _, ok := known[id]; !ok {
+		result.Status = CatalogIdUnknown
+		result.Suggestions = c.suggestions(id)
+	}
+
+	// IDs without a module are never valid
+	module, _, ok := strings.Cut(result.Id, ".")
+	if !ok {
+		return result
+	}
+	result.Module = module
+
+	if result.Status == CatalogIdUnknown && strings.EqualFold(module, baseModule) {
+		result.Status = CatalogIdUnlisted
+	} else if result.Status == CatalogIdUnknown && !c.modules[strings.ToLower(module)] {
+		result.Status = CatalogIdUnknownModule
+	}
+
+	return result
+}
+
+func check_item_id(id string) CatalogIdCheck {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return itemCatalog.check(id, gameTranslations["en-US"])
+}
+
+func check_vehicle_id(id string) CatalogIdCheck {
+	catalogMutex.RLock()
+	defer catalogMutex.RUnlock()
+
+	return vehicleCatalog.check(id, nil)
+}
+
+// validate_item_records drops the items of imported mods with an unknown ID and fixes the
+// casing of known IDs, game items missing from the catalog and items of unknown modules
+// are kept but warned about
+func (app *App) validate_item_records(itemRecords []ItemRecord) []ItemRecord {
+	valid := []ItemRecord{}
+	unknownModules := []string{}
+
+	for _, itemRecord := range itemRecords {
+		check := check_item_id(itemRecord.ItemId)
+		switch check.Status {
+		case CatalogIdUnknown:
+			runtime.LogWarningf(app.ctx, "Not adding unknown item %s, closest matches: %s", check.Id, strings.Join(check.Suggestions, ", "))
+			app.send_unknown_id_notification("rcon.addItems.unknown_item", "error", check)
+			continue
+		case CatalogIdUnlisted:
+			runtime.LogWarningf(app.ctx, "Item %s isn't in the catalog, adding it anyway", check.Id)
+			app.send_unknown_id_notification("rcon.addItems.unlisted_item", "warning", check)
+		case CatalogIdUnknownModule:
+			runtime.LogWarningf(app.ctx, "Item %s is from an unknown module", check.Id)
+			unknownModules = append_unique(unknownModules, check.Module)
+		}
+
+		itemRecord.ItemId = check.Id
+		valid = append(valid, itemRecord)
+	}
+
+	if len(unknownModules) > 0 {
+		app.SendNotification(Notification{
+			Title:   "rcon.addItems.unknown_modules",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"modules": strings.Join(unknownModules, ", "),
+			},
+		})
+	}
+
+	return valid
+}
+
+// send_unknown_id_notification sends prefix or prefix+"_suggestions" if there are close IDs
+func (app *App) send_unknown_id_notification(prefix string, variant string, check CatalogIdCheck) {
+	title := prefix
+	if len(check.Suggestions) > 0 {
+		title += "_suggestions"
+	}
+
+	app.SendNotification(Notification{
+		Title:   title,
+		Variant: variant,
+		Parameters: map[string]string{
+			"id":          check.Id,
+			"suggestions": strings.Join(check.Suggestions, ", "),
+		},
+	})
+}
+
+// CheckItemIds checks the item IDs against the item catalog and the imported mods
+func (app *App) CheckItemIds(ids []string) []CatalogIdCheck {
+	checks := make([]CatalogIdCheck, 0, len(ids))
+	for _, id := range ids {
+		checks = append(checks, check_item_id(id))
+	}
+
+	return checks
+}
+
+// CheckVehicleId checks the vehicle ID against the vehicle catalog and the imported mods
+func (app *App) CheckVehicleId(id string) CatalogIdCheck {
+	return check_vehicle_id(id)
+}
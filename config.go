@@ -37,7 +37,12 @@ type Config struct {
 	LastUpdateCheck              *int    `json:"lastUpdateCheck"`              // unix timestamp
 	RememberCredentials          *bool   `json:"rememberCredentials"`          // true, false
 	AutoConnect                  *bool   `json:"autoConnect"`                  // true, false
+	VaultIdleTimeout             *int    `json:"vaultIdleTimeout"`             // minutes, 0 = never lock
 	RconCheckInterval            *int    `json:"rconCheckInterval"`            // seconds
+	LatencyWarningThreshold      *int    `json:"latencyWarningThreshold"`      // milliseconds, p95 latency above this warns that the server is lagging
+	EnableMetrics                *bool   `json:"enableMetrics"`                // true, false
+	MetricsAddress               *string `json:"metricsAddress"`               // host:port the Prometheus /metrics endpoint listens on
+	AnalyticsRetentionDays       *int    `json:"analyticsRetentionDays"`       // days, 0 = keep forever
 	DisableWeatherControlButtons *bool   `json:"disableWeatherControlButtons"` // true, false
 	DisableRandomButtons         *bool   `json:"disableRandomButtons"`         // true, false
 	DisableOtherButtons          *bool   `json:"disableOtherButtons"`          // true, false
@@ -70,7 +75,12 @@ func GetDefaultConfig() Config {
 	defaultLastUpdateCheck := 0
 	defaultRememberCredentials := false
 	defaultAutoConnect := false
+	defaultVaultIdleTimeout := 15
 	defaultRconCheckInterval := 10
+	defaultLatencyWarningThreshold := 500
+	defaultEnableMetrics := false
+	defaultMetricsAddress := "127.0.0.1:9810"
+	defaultAnalyticsRetentionDays := 365
 	defaultDisableWeatherControlButtons := false
 	defaultDisableRandomButtons := false
 	defaultDisableOtherButtons := false
@@ -102,7 +112,12 @@ func GetDefaultConfig() Config {
 		LastUpdateCheck:              &defaultLastUpdateCheck,
 		RememberCredentials:          &defaultRememberCredentials,
 		AutoConnect:                  &defaultAutoConnect,
+		VaultIdleTimeout:             &defaultVaultIdleTimeout,
 		RconCheckInterval:            &defaultRconCheckInterval,
+		LatencyWarningThreshold:      &defaultLatencyWarningThreshold,
+		EnableMetrics:                &defaultEnableMetrics,
+		MetricsAddress:               &defaultMetricsAddress,
+		AnalyticsRetentionDays:       &defaultAnalyticsRetentionDays,
 		DisableWeatherControlButtons: &defaultDisableWeatherControlButtons,
 		DisableRandomButtons:         &defaultDisableRandomButtons,
 		DisableOtherButtons:          &defaultDisableOtherButtons,
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorcon/rcon"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const diagnosticsTimeout = 5 * time.Second
+
+const (
+	DiagnosticStatusOk      = "ok"
+	DiagnosticStatusError   = "error"
+	DiagnosticStatusSkipped = "skipped"
+)
+
+// Problems found by the diagnostics, used as translation keys for suggested fixes
+const (
+	DiagnosticProblemInvalidHost      = "invalid_host"
+	DiagnosticProblemInvalidPort      = "invalid_port"
+	DiagnosticProblemDnsFailed        = "dns_failed"
+	DiagnosticProblemPortClosed       = "port_closed"
+	DiagnosticProblemTimeout          = "timeout"
+	DiagnosticProblemSshFailed        = "ssh_failed"
+	DiagnosticProblemNotRcon          = "not_rcon"
+	DiagnosticProblemConnectionClosed = "connection_closed"
+	DiagnosticProblemWrongPassword    = "wrong_password"
+)
+
+type DiagnosticStep struct {
+	Name       string `json:"name"`       // host, dns, tcp, ssh, rcon
+	Status     string `json:"status"`     // ok, error, skipped
+	Message    string `json:"message"`    // Details, e.g. resolved addresses or the error
+	DurationMs int64  `json:"durationMs"` // Time the step took
+}
+
+type DiagnosticsReport struct {
+	Address    string           `json:"address"` // Normalized host:port that was checked
+	Steps      []DiagnosticStep `json:"steps"`
+	Success    bool             `json:"success"`
+	Problem    string           `json:"problem"`    // Empty on success
+	Suggestion string           `json:"suggestion"` // Translation key of the suggested fix
+}
+
+// normalize_host strips whitespace and the brackets of IPv6 literals
+func normalize_host(host string) string {
+	host = strings.TrimSpace(host)
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		host = host[1 : len(host)-1]
+	}
+
+	return host
+}
+
+// check_host normalizes the host of the credentials and rejects hosts with a port or
+// characters that can't be part of a hostname or an IP address
+func check_host(raw string) (string, error) {
+	host := normalize_host(raw)
+
+	// A bracketed IPv6 literal followed by a port, e.g. [::1]:27015
+	if strings.HasPrefix(host, "[") {
+		if _, _, err := net.SplitHostPort(host); err == nil {
+			return host, fmt.Errorf("Host %q contains a port, enter it in the port field", host)
+		}
+		return host, fmt.Errorf("Invalid host: %q", raw)
+	}
+
+	// IPv6 literals have at least two colons, a single one means a port was typed into the host
+	if net.ParseIP(host) == nil && strings.Count(host, ":") == 1 {
+		return host, fmt.Errorf("Host %q contains a port, enter it in the port field", host)
+	}
+
+	if host == "" || strings.ContainsAny(host, " /[]") {
+		return host, fmt.Errorf("Invalid host: %q", raw)
+	}
+
+	return host, nil
+}
+
+// rcon_address joins host and port, bracketing IPv6 literals
+func rcon_address(credentials Credentials) string {
+	return net.JoinHostPort(normalize_host(credentials.IP), strings.TrimSpace(credentials.Port))
+}
+
+func (report *DiagnosticsReport) addStep(name string, status string, message string, started time.Time) {
+	report.Steps = append(report.Steps, DiagnosticStep{
+		Name:       name,
+		Status:     status,
+		Message:    message,
+		DurationMs: time.Since(started).Milliseconds(),
+	})
+}
+
+func (report *DiagnosticsReport) fail(name string, problem string, message string, started time.Time) DiagnosticsReport {
+	report.addStep(name, DiagnosticStatusError, message, started)
+	report.Problem = problem
+	report.Suggestion = "admin_panel.tabs.connection.diagnostics.suggestions." + problem
+
+	for _, step := range []string{"host", "dns", "tcp", "ssh", "rcon"} {
+		found := false
+		for _, done := range report.Steps {
+			if done.Name == step {
+				found = true
+				break
+			}
+		}
+		if !found {
+			report.Steps = append(report.Steps, DiagnosticStep{Name: step, Status: DiagnosticStatusSkipped})
+		}
+	}
+
+	return *report
+}
+
+// classify_dial_error tells a closed port apart from a filtered one
+func classify_dial_error(err error) string {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return DiagnosticProblemTimeout
+	}
+
+	return DiagnosticProblemPortClosed
+}
+
+// classify_rcon_error tells a wrong password apart from a port that doesn't speak RCON
+func classify_rcon_error(err error) string {
+	switch {
+	case errors.Is(err, rcon.ErrAuthFailed):
+		return DiagnosticProblemWrongPassword
+	case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return DiagnosticProblemConnectionClosed
+	default:
+		// Includes timeouts, the port accepted the connection but never answered like an RCON server
+		return DiagnosticProblemNotRcon
+	}
+}
+
+// DiagnoseConnection checks each stage of connecting to the RCON server and
+// reports the first one that fails together with a suggested fix
+func (app *App) DiagnoseConnection(credentials Credentials) DiagnosticsReport {
+	report := DiagnosticsReport{Steps: []DiagnosticStep{}}
+	runtime.LogInfo(app.ctx, "Running connection diagnostics")
+
+	// Host and port
+	started := time.Now()
+	port := strings.TrimSpace(credentials.Port)
+
+	host, err := check_host(credentials.IP)
+	if err != nil {
+		return report.fail("host", DiagnosticProblemInvalidHost, err.Error(), started)
+	}
+
+	portNumber, err := strconv.Atoi(port)
+	if err != nil || portNumber < 1 || portNumber > 65535 {
+		return report.fail("host", DiagnosticProblemInvalidPort, fmt.Sprintf("Invalid port: %q", credentials.Port), started)
+	}
+
+	report.Address = net.JoinHostPort(host, port)
+	hostKind := "hostname"
+	if ip := net.ParseIP(host); ip != nil {
+		hostKind = "IPv4 address"
+		if ip.To4() == nil {
+			hostKind = "IPv6 address"
+		}
+	}
+	report.addStep("host", DiagnosticStatusOk, fmt.Sprintf("%s (%s)", report.Address, hostKind), started)
+
+	// Through an SSH tunnel DNS and TCP are checked for the jump host, RCON is reached from there
+	dialHost, dialPort := host, port
+	if credentials.SSH != nil {
+		dialHost = normalize_host(credentials.SSH.Host)
+		dialPort = credentials.SSH.Port
+		if dialPort == "" {
+			dialPort = "22"
+		}
+	}
+
+	// DNS
+	started = time.Now()
+	var addresses []string
+	if net.ParseIP(dialHost) != nil {
+		addresses = []string{dialHost}
+		report.addStep("dns", DiagnosticStatusSkipped, "Host is an IP address", started)
+	} else {
+		ctx, cancel := context.WithTimeout(context.Background(), diagnosticsTimeout)
+		ipAddrs, err := net.DefaultResolver.LookupIPAddr(ctx, dialHost)
+		cancel()
+		if err != nil || len(ipAddrs) == 0 {
+			message := fmt.Sprintf("Could not resolve %s", dialHost)
+			if err != nil {
+				message = err.Error()
+			}
+			return report.fail("dns", DiagnosticProblemDnsFailed, message, started)
+		}
+
+		for _, ipAddr := range ipAddrs {
+			addresses = append(addresses, ipAddr.IP.String())
+		}
+		report.addStep("dns", DiagnosticStatusOk, strings.Join(addresses, ", "), started)
+	}
+
+	// TCP, trying each resolved address like a regular dial would
+	started = time.Now()
+	var tcpConn net.Conn
+	var dialErrs []string
+	for _, address := range addresses {
+		tcpConn, err = net.DialTimeout("tcp", net.JoinHostPort(address, dialPort), diagnosticsTimeout)
+		if err == nil {
+			break
+		}
+		dialErrs = append(dialErrs, err.Error())
+	}
+	if tcpConn == nil {
+		return report.fail("tcp", classify_dial_error(err), strings.Join(dialErrs, "; "), started)
+	}
+	report.addStep("tcp", DiagnosticStatusOk, fmt.Sprintf("Connected to %s", tcpConn.RemoteAddr().String()), started)
+
+	// RCON
+	if credentials.SSH == nil {
+		report.addStep("ssh", DiagnosticStatusSkipped, "", time.Now())
+
+		started = time.Now()
+		rconConn, err := rcon.Open(tcpConn, credentials.Password, rcon.SetDeadline(diagnosticsTimeout))
+		if err != nil {
+			return report.fail("rcon", classify_rcon_error(err), err.Error(), started)
+		}
+		rconConn.Close()
+		report.addStep("rcon", DiagnosticStatusOk, "Authenticated", started)
+	} else {
+		tcpConn.Close()
+
+		started = time.Now()
+		client, agentConn, err := dial_ssh(*credentials.SSH)
+		if err != nil {
+			return report.fail("ssh", DiagnosticProblemSshFailed, err.Error(), started)
+		}
+		defer client.Close()
+		if agentConn != nil {
+			defer agentConn.Close()
+		}
+		report.addStep("ssh", DiagnosticStatusOk, "Authenticated", started)
+
+		started = time.Now()
+		remote, err := client.Dial("tcp", report.Address)
+		if err != nil {
+			return report.fail("rcon", DiagnosticProblemPortClosed, err.Error(), started)
+		}
+
+		// SSH channels don't support deadlines, close the channel instead when it takes too long
+		timer := time.AfterFunc(diagnosticsTimeout, func() { remote.Close() })
+		rconConn, err := rcon.Open(remote, credentials.Password, rcon.SetDeadline(0))
+		timedOut := !timer.Stop()
+		if timedOut && err != nil {
+			return report.fail("rcon", DiagnosticProblemNotRcon, "No RCON response within "+diagnosticsTimeout.String(), started)
+		}
+		if err != nil {
+			return report.fail("rcon", classify_rcon_error(err), err.Error(), started)
+		}
+		rconConn.Close()
+		report.addStep("rcon", DiagnosticStatusOk, "Authenticated", started)
+	}
+
+	report.Success = true
+	runtime.LogInfo(app.ctx, "Connection diagnostics passed")
+
+	return report
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckHost(t *testing.T) {
+	tests := []struct {
+		host     string
+		wantHost string
+		wantErr  string // Part of the error, empty if the host is valid
+	}{
+		{" example.com ", "example.com", ""},
+		{"192.168.1.10", "192.168.1.10", ""},
+		{"::1", "::1", ""},
+		{"[::1]", "::1", ""},
+		{"[2001:db8::1]", "2001:db8::1", ""},
+		{"example.com:27015", "", "contains a port"},
+		{"192.168.1.10:27015", "", "contains a port"},
+		{"[::1]:27015", "", "contains a port"},
+		{"[2001:db8::1]:27015", "", "contains a port"},
+		{"[::1", "", "Invalid host"},
+		{"::1]", "", "Invalid host"},
+		{"", "", "Invalid host"},
+		{"my server", "", "Invalid host"},
+		{"example.com/rcon", "", "Invalid host"},
+	}
+
+	for _, test := range tests {
+		host, err := check_host(test.host)
+		switch {
+		case test.wantErr == "" && err != nil:
+			t.Errorf("%q: unexpected error %v", test.host, err)
+		case test.wantErr == "" && host != test.wantHost:
+			t.Errorf("%q: host = %q, want %q", test.host, host, test.wantHost)
+		case test.wantErr != "" && (err == nil || !strings.Contains(err.Error(), test.wantErr)):
+			t.Errorf("%q: error %v, want %q", test.host, err, test.wantErr)
+		}
+	}
+}
@@ -2,7 +2,9 @@ package main
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
@@ -160,7 +162,33 @@ func (a *App) LoadItemsDialog() []ItemRecord {
 		return nil
 	}
 
-	return items
+	// Strip malformed and unknown items, unlisted game items and items of unknown modules are kept
+	valid := []ItemRecord{}
+	removed := []string{}
+	for _, item := range items {
+		check := check_item_id(item.ItemId)
+		if check.Id == "" || item.Count <= 0 || check.Status == CatalogIdUnknown {
+			removed = append(removed, fmt.Sprintf("%q", item.ItemId))
+			continue
+		}
+
+		item.ItemId = check.Id
+		valid = append(valid, item)
+	}
+
+	if len(removed) > 0 {
+		runtime.LogWarningf(a.ctx, "Removed %d invalid items from %s: %s", len(removed), path, strings.Join(removed, ", "))
+		app.SendNotification(Notification{
+			Title:   "admin_panel.tabs.players.dialogs.additem.notifications.invalid_items_removed",
+			Message: strings.Join(removed, ", "),
+			Variant: "warning",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", len(removed)),
+			},
+		})
+	}
+
+	return valid
 }
 
 func (a *App) SaveMessagesDialog(message ServerMessage) {
@@ -317,7 +345,11 @@ func (a *App) ImportOptionsDialog() ImportOptionsResponse {
 	}
 
 	var options PzOptions
-	err = readJSON(path, &options)
+	var mismatched []string
+	data, err := os.ReadFile(path)
+	if err == nil {
+		options, mismatched, err = decode_options(data)
+	}
 	if err != nil {
 		runtime.LogWarning(a.ctx, err.Error())
 		app.SendNotification(Notification{
@@ -327,6 +359,19 @@ func (a *App) ImportOptionsDialog() ImportOptionsResponse {
 		return ImportOptionsResponse{Success: false}
 	}
 
+	// Options of other game versions may have another type, they are imported with the type of their value
+	if len(mismatched) > 0 {
+		runtime.LogWarningf(a.ctx, "Imported options don't match the schema types: %s", strings.Join(mismatched, ", "))
+		app.SendNotification(Notification{
+			Title:   "admin_panel.tabs.options.notifications.options_type_mismatch",
+			Message: strings.Join(mismatched, ", "),
+			Variant: "warning",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", len(mismatched)),
+			},
+		})
+	}
+
 	return ImportOptionsResponse{Options: options, Success: true}
 }
 
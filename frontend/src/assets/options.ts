@@ -27,6 +27,11 @@ export type Option = {
   }[];
 };
 
+export type OptionValue = boolean | number | string;
+
+// Server options by name, includes options missing from the categories
+export type PzOptions = Record<string, OptionValue>;
+
 export type Category = {
   name: string;
   options: Option[];
@@ -1175,3 +1180,17 @@ export const options: Options = {
 
 export const optionsFlat: Option[] = options.categories.flatMap((category) => category.options);
 export const optionsMap: Map<string, Option> = new Map(optionsFlat.map((option) => [option.FieldName, option]));
+
+// Types of the options missing from the categories, by the option types of the server
+const unknownOptionTypes: Record<string, Option["Type"]> = {
+  bool: "Boolean",
+  int: "Integer",
+  float: "Double",
+  string: "String",
+};
+
+export const unknownOptions = (optionTypes: Record<string, string>): Option[] =>
+  Object.entries(optionTypes)
+    .filter(([fieldName]) => !optionsMap.has(fieldName))
+    .map(([fieldName, type]) => ({ FieldName: fieldName, Type: unknownOptionTypes[type] ?? "String" }))
+    .sort((a, b) => a.FieldName.localeCompare(b.FieldName));
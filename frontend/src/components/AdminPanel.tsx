@@ -11,12 +11,16 @@ import { Form, FormControl, FormField, FormItem, FormLabel, FormMessage } from "
 import { Input } from "@/components/ui/input";
 import { main } from "@/wailsjs/go/models";
 import { Checkbox } from "./ui/checkbox";
-import { DeleteCredentials, LoadCredentials, SaveCredentials } from "@/wailsjs/go/main/App";
+import { DeleteCredentials, GetVaultStatus, LoadCredentials, LockVault, SaveCredentials } from "@/wailsjs/go/main/App";
+import { EventsOff, EventsOn } from "@/wailsjs/runtime/runtime";
 import { useConfig } from "@/contexts/config-provider";
 import { LoaderCircle } from "lucide-react";
 import { PlayersTab } from "./Players";
 import { ManagementTab } from "./Management";
 import { OptionsTab } from "./Options";
+import { VaultDialog } from "./Dialogs/VaultDialog";
+import { DiagnosticsDialog } from "./Dialogs/DiagnosticsDialog";
+import { ConnectionHealthIndicator } from "./ConnectionHealthIndicator";
 
 export default function AdminPanel() {
   const { isConnected, disconnect, ip, port } = useRcon();
@@ -99,6 +103,7 @@ export default function AdminPanel() {
           {isConnected && (
             <div className="text-xs space-y-2 w-full">
               <p>{t("admin_panel.connected_to", { socket: `${ip}:${port}` })}</p>
+              <ConnectionHealthIndicator />
               <Button className="w-full" variant={"destructive"} onClick={handleDisconnect}>
                 {t("admin_panel.disconnect")}
               </Button>
@@ -149,25 +154,63 @@ function ConnectionForm({ defaultValues }: ConnectionFormProps) {
 
   const { config, setConfigField } = useConfig();
   const [oneTime, setOneTime] = useState(true);
+  const [vaultStatus, setVaultStatus] = useState<main.VaultStatus>({ exists: false, unlocked: false });
+  const [vaultDialogMode, setVaultDialogMode] = useState<"create" | "unlock" | null>(null);
+  const [diagnosticsCredentials, setDiagnosticsCredentials] = useState<main.Credentials | null>(null);
+
+  // Failed connections open the diagnostics to show which step failed
+  const connectOrDiagnose = async (credentials: main.Credentials) => {
+    const success = await connect(credentials);
+    if (!success) {
+      setDiagnosticsCredentials(credentials);
+    }
+
+    return success;
+  };
+
+  const loadCredentials = (autoConnect: boolean) => {
+    LoadCredentials().then((credentials) => {
+      if (credentials && credentials.ip && credentials.password)
+        form.reset({
+          ip: credentials?.ip || "",
+          port: credentials?.port || "",
+          password: credentials?.password || "",
+        });
+
+      if (autoConnect && credentials.ip && credentials.password) {
+        connectOrDiagnose({ ip: credentials.ip, port: credentials.port || "27015", password: credentials.password });
+      }
+    });
+  };
 
   useEffect(() => {
     if (config && oneTime) {
       setOneTime(false);
-      LoadCredentials().then((credentials) => {
-        if (credentials && credentials.ip && credentials.password)
-          form.reset({
-            ip: credentials?.ip || "",
-            port: credentials?.port || "",
-            password: credentials?.password || "",
-          });
-
-        if (config?.autoConnect) {
-          connect({ ip: credentials.ip, port: credentials.port || "27015", password: credentials.password });
+      GetVaultStatus().then((status) => {
+        setVaultStatus(status);
+
+        // A locked vault is loaded after the master password is entered
+        if (status.exists && !status.unlocked) {
+          setVaultDialogMode("unlock");
+        } else {
+          loadCredentials(config?.autoConnect ?? false);
         }
       });
     }
   }, [oneTime, config]);
 
+  useEffect(() => {
+    const handleVaultChange = () => GetVaultStatus().then(setVaultStatus);
+
+    EventsOn("vaultUnlocked", handleVaultChange);
+    EventsOn("vaultLocked", handleVaultChange);
+
+    return () => {
+      EventsOff("vaultUnlocked");
+      EventsOff("vaultLocked");
+    };
+  }, []);
+
   // Define form schema
   const formSchema = z.object({
     ip: z
@@ -194,11 +237,11 @@ function ConnectionForm({ defaultValues }: ConnectionFormProps) {
   // Handle form submission
   function onSubmit(data: z.infer<typeof formSchema>) {
     if (!isConnected) {
-      connect({ ip: data.ip, port: data.port || "27015", password: data.password }).then((success) => {
+      connectOrDiagnose({ ip: data.ip, port: data.port || "27015", password: data.password }).then((success) => {
         if (success && config?.rememberCredentials) {
           SaveCredentials(data);
         } else if (!config?.rememberCredentials) {
-          DeleteCredentials();
+          DeleteCredentials(data);
         }
       });
     }
@@ -206,6 +249,18 @@ function ConnectionForm({ defaultValues }: ConnectionFormProps) {
 
   return (
     <>
+      <VaultDialog
+        isOpen={vaultDialogMode !== null}
+        onClose={() => setVaultDialogMode(null)}
+        mode={vaultDialogMode ?? "unlock"}
+        onSuccess={() => vaultDialogMode === "unlock" && loadCredentials(config?.autoConnect ?? false)}
+      />
+      <DiagnosticsDialog
+        isOpen={diagnosticsCredentials !== null}
+        onClose={() => setDiagnosticsCredentials(null)}
+        credentials={diagnosticsCredentials}
+      />
+
       {isConnecting && (
         <div className="absolute top-1/2 left-1/2 -translate-x-1/2 -translate-y-1/2 z-[50]">
           <LoaderCircle className="w-20 h-20 animate-spin" />
@@ -307,10 +362,52 @@ function ConnectionForm({ defaultValues }: ConnectionFormProps) {
               </label>
             </div>
 
+            {/* Credential vault */}
+            <div className="flex items-center justify-between text-sm text-muted-foreground">
+              {vaultStatus.exists ? (
+                <>
+                  <span>
+                    {vaultStatus.unlocked
+                      ? t("admin_panel.tabs.connection.vault.unlocked")
+                      : t("admin_panel.tabs.connection.vault.locked")}
+                  </span>
+                  {vaultStatus.unlocked ? (
+                    <Button type="button" variant="outline" size="sm" onClick={() => LockVault()}>
+                      {t("admin_panel.tabs.connection.vault.lock")}
+                    </Button>
+                  ) : (
+                    <Button type="button" variant="outline" size="sm" onClick={() => setVaultDialogMode("unlock")}>
+                      {t("admin_panel.tabs.connection.vault.unlock")}
+                    </Button>
+                  )}
+                </>
+              ) : (
+                config?.rememberCredentials && (
+                  <>
+                    <span>{t("admin_panel.tabs.connection.vault.not_protected")}</span>
+                    <Button type="button" variant="outline" size="sm" onClick={() => setVaultDialogMode("create")}>
+                      {t("admin_panel.tabs.connection.vault.create")}
+                    </Button>
+                  </>
+                )
+              )}
+            </div>
+
             {/* Submit Button */}
             <Button type="submit" className="w-full" disabled={isConnecting || isConnected}>
               {t("admin_panel.tabs.connection.connect")}
             </Button>
+            <Button
+              type="button"
+              variant="outline"
+              className="w-full"
+              disabled={isConnecting}
+              onClick={form.handleSubmit((data) =>
+                setDiagnosticsCredentials({ ip: data.ip, port: data.port || "27015", password: data.password })
+              )}
+            >
+              {t("admin_panel.tabs.connection.diagnostics.run")}
+            </Button>
           </div>
         </form>
       </Form>
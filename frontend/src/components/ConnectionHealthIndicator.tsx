@@ -0,0 +1,51 @@
+import { HoverCard, HoverCardContent, HoverCardTrigger } from "@/components/ui/hover-card";
+import { GetConnectionHealth } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { EventsOn } from "@/wailsjs/runtime/runtime";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+function formatUptime(seconds: number): string {
+  const hours = Math.floor(seconds / 3600);
+  const minutes = Math.floor((seconds % 3600) / 60);
+
+  return hours > 0 ? `${hours}h ${minutes}m` : `${minutes}m`;
+}
+
+export function ConnectionHealthIndicator() {
+  const { t } = useTranslation();
+  const [health, setHealth] = useState<main.ConnectionHealth>();
+
+  useEffect(() => {
+    GetConnectionHealth().then(setHealth);
+
+    // Sent by the connection watcher on every check
+    return EventsOn("connection-health", setHealth);
+  }, []);
+
+  if (!health) return null;
+
+  const status = !health.connected ? "disconnected" : health.lagging ? "lagging" : "healthy";
+  const color = { healthy: "bg-green-500", lagging: "bg-yellow-500", disconnected: "bg-destructive" }[status];
+
+  return (
+    <HoverCard openDelay={200}>
+      <HoverCardTrigger asChild>
+        <div className="flex items-center gap-2 cursor-default">
+          <span className={`w-2 h-2 rounded-full shrink-0 ${color}`} />
+          <span>{t(`admin_panel.health.${status}`)}</span>
+          {health.connected && health.requests > 0 && (
+            <span className="ml-auto text-muted-foreground">{Math.round(health.lastLatencyMs)} ms</span>
+          )}
+        </div>
+      </HoverCardTrigger>
+      <HoverCardContent side="right" align="end" className="text-xs space-y-1">
+        <p>{t("admin_panel.health.latency", { p50: Math.round(health.latencyP50Ms), p95: Math.round(health.latencyP95Ms) })}</p>
+        <p>{t("admin_panel.health.error_rate", { rate: (health.errorRate * 100).toFixed(1) })}</p>
+        <p>{t("admin_panel.health.reconnects", { n: health.reconnects })}</p>
+        {health.connected && <p>{t("admin_panel.health.uptime", { uptime: formatUptime(health.uptimeSeconds) })}</p>}
+        {health.lagging && <p className="text-yellow-500">{t("admin_panel.health.lagging_description")}</p>}
+      </HoverCardContent>
+    </HoverCard>
+  );
+}
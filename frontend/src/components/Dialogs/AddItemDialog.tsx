@@ -9,11 +9,22 @@ import { ScrollArea } from "../ui/scroll-area";
 import { Tooltip, TooltipContent, TooltipTrigger } from "@/components/ui/tooltip";
 import itemsData from "@/assets/items.json";
 import { main } from "@/wailsjs/go/models";
-import { AddItems, CopyToClipboard, LoadItemsDialog, SaveItemsDialog } from "@/wailsjs/go/main/App";
+import {
+  AddItems,
+  CheckItemIds,
+  ClearModCatalog,
+  CopyToClipboard,
+  GetModCatalog,
+  ImportModCatalogDialog,
+  LoadItemsDialog,
+  SaveItemsDialog,
+} from "@/wailsjs/go/main/App";
+import { EventsOn } from "@/wailsjs/runtime/runtime";
 import { useTranslation } from "react-i18next";
 import { Checkbox } from "../ui/checkbox";
 import { Label } from "../ui/label";
 import { useConfig } from "@/contexts/config-provider";
+import { useRcon } from "@/contexts/rcon-provider";
 
 interface AddItemDialogProps {
   isOpen: boolean;
@@ -46,16 +57,40 @@ export function AddItemDialog({
   height,
 }: AddItemDialogProps) {
   const { config } = useConfig();
+  const { isConnected } = useRcon();
   const { t } = useTranslation("items");
-  const { t: tc } = useTranslation();
+  const { t: tc, i18n } = useTranslation();
 
   const [showIds, setShowIds] = useState(false);
   const [customItemId, setCustomItemId] = useState("");
+  const [customItemCheck, setCustomItemCheck] = useState<main.CatalogIdCheck>();
+  const [modCatalog, setModCatalog] = useState<main.ModCatalog>();
 
-  const sortedItemsData = useMemo(
-    () => itemsData.sort((a, b) => tc(`item_categories.${a.name}`).localeCompare(tc(`item_categories.${b.name}`))),
-    []
-  );
+  // Mod categories aren't translated
+  const categoryLabel = (name: string) => tc(`item_categories.${name}`, { defaultValue: name });
+
+  const sortedItemsData: Category[] = useMemo(() => {
+    const categories: Category[] = itemsData.map((category: Category) => ({ ...category, items: [...category.items] }));
+    const baseIds = new Set(categories.flatMap((category) => category.items.map((item) => item.itemId)));
+
+    // Mod items go to the category with the same name if there is one
+    for (const entry of modCatalog?.items ?? []) {
+      if (baseIds.has(entry.id)) continue;
+
+      let category = categories.find((category) => category.name === entry.category);
+      if (!category) {
+        category = { name: entry.category, items: [] };
+        categories.push(category);
+      }
+      category.items.push({
+        name: modCatalog?.translations?.[i18n.language]?.[entry.id] ?? entry.name,
+        itemId: entry.id,
+        images: entry.images ?? [],
+      });
+    }
+
+    return categories.sort((a, b) => categoryLabel(a.name).localeCompare(categoryLabel(b.name)));
+  }, [modCatalog]);
 
   const translateWithFallback = (key: string, fallback = key) => {
     const translation = t(key);
@@ -80,7 +115,7 @@ export function AddItemDialog({
       const query = searchQuery.toLowerCase();
       return category.items.filter(
         (item) =>
-          tc(`item_categories.${category.name}`).toLowerCase().includes(query) ||
+          categoryLabel(category.name).toLowerCase().includes(query) ||
           translateWithFallback(item.itemId, item.name).toLowerCase().includes(query)
       );
     };
@@ -95,7 +130,7 @@ export function AddItemDialog({
     }
 
     return filtered.filter((category) => selectedFilters.includes(category.name));
-  }, [searchQuery, selectedFilters]);
+  }, [searchQuery, selectedFilters, sortedItemsData]);
 
   const handleAddItems = () => {
     if (!names) return;
@@ -128,8 +163,20 @@ export function AddItemDialog({
     const trimmed = customItemId.trim();
     if (!trimmed) return;
 
-    handleAddItem(trimmed);
+    // Unknown IDs aren't added, unlisted game items and items of unknown modules are added with a warning
+    CheckItemIds([trimmed]).then(([check]) => {
+      setCustomItemCheck(check.status === "known" ? undefined : check);
+      if (check.status === "unknown") return;
+
+      handleAddItem(check.id);
+      setCustomItemId("");
+    });
+  };
+
+  const handleAddSuggestion = (itemId: string) => {
+    handleAddItem(itemId);
     setCustomItemId("");
+    setCustomItemCheck(undefined);
   };
 
   const handleRemoveItem = (itemId: string) => {
@@ -177,6 +224,14 @@ export function AddItemDialog({
     });
   };
 
+  useEffect(() => {
+    GetModCatalog().then(setModCatalog);
+
+    return EventsOn("update-mod-catalog", (catalog: main.ModCatalog) => {
+      setModCatalog(catalog);
+    });
+  }, []);
+
   useEffect(() => {
     setSearchQuery("");
     setSelectedFilters([]);
@@ -184,6 +239,7 @@ export function AddItemDialog({
     setResetNum((prev) => prev + 1);
     setShowIds(false);
     setCustomItemId("");
+    setCustomItemCheck(undefined);
 
     if (config?.windowScale! >= 140 && mode !== "tool") {
       if (isOpen) {
@@ -310,7 +366,7 @@ export function AddItemDialog({
           <Combobox
             elements={sortedItemsData.map((category: Category) => ({
               value: category.name,
-              label: tc(`item_categories.${category.name}`),
+              label: categoryLabel(category.name),
             }))}
             multiSelect
             unselectAllButton
@@ -332,7 +388,7 @@ export function AddItemDialog({
               (category) =>
                 category.items.length > 0 && (
                   <AccordionItem key={category.name} value={category.name}>
-                    <AccordionTrigger className="h-8">{tc(`item_categories.${category.name}`)}</AccordionTrigger>
+                    <AccordionTrigger className="h-8">{categoryLabel(category.name)}</AccordionTrigger>
                     <AccordionContent className="flex flex-col items-start ml-2">
                       {category.items.map((item) => (
                         <Button
@@ -407,6 +463,32 @@ export function AddItemDialog({
             <PlusCircle strokeWidth={2} aria-hidden="true" className="w-5 h-5 shrink-0" />
           </Button>
         </div>
+        {customItemCheck && (
+          <div className="flex flex-wrap items-center gap-x-2 pr-4 mb-2 text-xs text-muted-foreground">
+            {customItemCheck.status === "unknown" || customItemCheck.status === "unlisted" ? (
+              <>
+                {customItemCheck.status === "unknown" ? (
+                  <span className="text-destructive">{tc("tools.item_browser.unknown_item")}</span>
+                ) : (
+                  <span>{tc("tools.item_browser.unlisted_item")}</span>
+                )}
+                {customItemCheck.suggestions.length > 0 && <span>{tc("tools.item_browser.did_you_mean")}</span>}
+                {customItemCheck.suggestions.map((suggestion) => (
+                  <Button
+                    key={suggestion}
+                    variant="link"
+                    className="p-0 h-fit text-xs"
+                    onClick={() => handleAddSuggestion(suggestion)}
+                  >
+                    {suggestion}
+                  </Button>
+                ))}
+              </>
+            ) : (
+              <span>{tc("tools.item_browser.unknown_module", { module: customItemCheck.module })}</span>
+            )}
+          </div>
+        )}
         <ScrollArea className="pr-4 mb-4" style={{ height: height ? `calc(${height} - 5rem)` : "28.5rem" }}>
           <div className="grid grid-cols-1 lg:grid-cols-2 2xl:grid-cols-3">{renderSelectedItems()}</div>
           <div ref={scrollRef} />
@@ -419,6 +501,14 @@ export function AddItemDialog({
             <Button variant={"outline"} onClick={handleLoadItems}>
               {tc("tools.item_browser.load_items")}
             </Button>
+            <Button variant={"outline"} onClick={() => ImportModCatalogDialog()} disabled={!isConnected}>
+              {tc("tools.item_browser.import_mod_items")}
+            </Button>
+            {(modCatalog?.items?.length ?? 0) > 0 && (
+              <Button variant={"outline"} onClick={() => ClearModCatalog()} disabled={!isConnected}>
+                {tc("tools.item_browser.clear_mod_items")}
+              </Button>
+            )}
 
             {mode === "tool" && (
               <div className="relative w-full">
@@ -1,4 +1,4 @@
-import { useState, useEffect, Fragment, ImgHTMLAttributes } from "react";
+import { useState, useEffect, useMemo, Fragment, ImgHTMLAttributes } from "react";
 import {
   Breadcrumb,
   BreadcrumbItem,
@@ -24,10 +24,10 @@ import { Card } from "../ui/card";
 import { Combobox } from "../ui/combobox";
 import { Label } from "../ui/label";
 import { Input } from "../ui/input";
-import { BrowserOpenURL } from "@/wailsjs/runtime/runtime";
+import { BrowserOpenURL, EventsOn } from "@/wailsjs/runtime/runtime";
 import { main } from "@/wailsjs/go/models";
 import { useRcon } from "@/contexts/rcon-provider";
-import { AddVehicle } from "@/wailsjs/go/main/App";
+import { AddVehicle, GetModCatalog } from "@/wailsjs/go/main/App";
 import { useTranslation } from "react-i18next";
 
 // Define the Vehicle interface
@@ -84,7 +84,8 @@ export function AddVehicleDialog({
   //const onlinePlayers = players;
 
   const [path, setPath] = useState<Vehicle[]>([]);
-  const [vehicles, setVehicles] = useState<VehicleData>([]);
+  const [baseVehicles, setBaseVehicles] = useState<VehicleData>([]);
+  const [modVehicles, setModVehicles] = useState<main.CatalogEntry[]>([]);
   const [loading, setLoading] = useState(true);
   const [error, setError] = useState<string | null>(null);
 
@@ -114,7 +115,7 @@ export function AddVehicleDialog({
       setLoading(true);
       try {
         const data = await loadVehicleData();
-        setVehicles(data);
+        setBaseVehicles(data);
       } catch (err) {
         setError("Failed to load vehicle data.");
       } finally {
@@ -125,6 +126,34 @@ export function AddVehicleDialog({
     fetchVehicles();
   }, []);
 
+  useEffect(() => {
+    GetModCatalog().then((catalog) => setModVehicles(catalog.vehicles ?? []));
+
+    return EventsOn("update-mod-catalog", (catalog: main.ModCatalog) => {
+      setModVehicles(catalog.vehicles ?? []);
+    });
+  }, []);
+
+  // Imported mod vehicles are listed in a category per mod
+  const vehicles: VehicleData = useMemo(() => {
+    const categories: Vehicle[] = [];
+    for (const entry of modVehicles) {
+      let category = categories.find((category) => category.name === entry.category);
+      if (!category) {
+        category = { name: entry.category, type: "category", children: [] };
+        categories.push(category);
+      }
+      category.children!.push({
+        name: entry.name,
+        type: "type",
+        id: entry.id,
+        images: entry.images?.length ? entry.images : undefined,
+      });
+    }
+
+    return [...baseVehicles, ...categories];
+  }, [baseVehicles, modVehicles]);
+
   useEffect(() => {
     setSelectedNames(initialNames.filter((name) => onlinePlayers.some((player) => player.name === name)));
     setTab(initialTab);
@@ -11,7 +11,10 @@ import { ToggleGroup, ToggleGroupItem } from "@/components/ui/toggle-group";
 import { Label } from "../ui/label";
 import { Input } from "../ui/input";
 import { useEffect, useState } from "react";
-import { AddXp } from "@/wailsjs/go/main/App";
+import { AddPerkLevels, AddXp, PreviewPerkLevels, SetPerkLevel } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { Checkbox } from "../ui/checkbox";
+import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "../ui/select";
 import { useTranslation } from "react-i18next";
 import { useConfig } from "@/contexts/config-provider";
 
@@ -61,6 +64,41 @@ export function AddXpDialog({ isOpen, onClose, names }: AddXpDialogProps) {
   const [selectedRegularXp, setSelectedRegularXp] = useState<string[]>([]);
   const [selectedPassiveXp, setSelectedPassiveXp] = useState<string[]>([]);
 
+  const [levelMode, setLevelMode] = useState("set");
+  const [currentLevel, setCurrentLevel] = useState("0");
+  const [level, setLevel] = useState("");
+  const [compensateBoost, setCompensateBoost] = useState(false);
+  const [boost, setBoost] = useState("0");
+  const [levelPreview, setLevelPreview] = useState([] as main.PerkXpPreview[]);
+
+  const levelRequest = {
+    perks: selectedPerks,
+    currentLevel: parseInt(currentLevel) || 0,
+    level: parseInt(level) || 0,
+    multiplier: 1,
+    compensateBoost: compensateBoost,
+    boost: parseInt(boost) || 0,
+  } as main.PerkLevelRequest;
+
+  useEffect(() => {
+    if (selectedPerks.length === 0 || level === "") {
+      setLevelPreview([]);
+      return;
+    }
+
+    PreviewPerkLevels(levelRequest, levelMode === "add").then((preview) => setLevelPreview(preview ?? []));
+  }, [selectedPerks, levelMode, currentLevel, level, compensateBoost, boost]);
+
+  const handleApplyLevels = () => {
+    if (levelMode === "add") {
+      AddPerkLevels(names, levelRequest);
+    } else {
+      SetPerkLevel(names, levelRequest);
+    }
+
+    onClose();
+  };
+
   const handleTogglePerk = (perkName: string) => {
     setSelectedPerks((prev) =>
       prev.includes(perkName) ? prev.filter((perk) => perk !== perkName) : [...prev, perkName]
@@ -95,6 +133,8 @@ export function AddXpDialog({ isOpen, onClose, names }: AddXpDialogProps) {
     setCount("");
     setSelectedRegularXp([]);
     setSelectedPassiveXp([]);
+    setLevel("");
+    setCurrentLevel("0");
 
     if (config?.windowScale! >= 140) {
       if (isOpen) {
@@ -198,6 +238,73 @@ export function AddXpDialog({ isOpen, onClose, names }: AddXpDialogProps) {
           </div>
         </div>
 
+        <div className="space-y-2">
+          <h4 className="text-md font-medium">{t("admin_panel.tabs.players.dialogs.addxp.levels.title")}</h4>
+          <div className="flex items-end gap-2">
+            <Select value={levelMode} onValueChange={setLevelMode}>
+              <SelectTrigger className="w-40">
+                <SelectValue />
+              </SelectTrigger>
+              <SelectContent>
+                <SelectItem value="set">{t("admin_panel.tabs.players.dialogs.addxp.levels.set")}</SelectItem>
+                <SelectItem value="add">{t("admin_panel.tabs.players.dialogs.addxp.levels.add")}</SelectItem>
+              </SelectContent>
+            </Select>
+            <div className="space-y-1">
+              <Label htmlFor="xp-level">
+                {levelMode === "add"
+                  ? t("admin_panel.tabs.players.dialogs.addxp.levels.levels")
+                  : t("admin_panel.tabs.players.dialogs.addxp.levels.level")}
+              </Label>
+              <Input value={level} onChange={(e) => setLevel(e.target.value)} min={0} max={10} id="xp-level" type="number" />
+            </div>
+            <div className="space-y-1">
+              <Label htmlFor="xp-current-level">{t("admin_panel.tabs.players.dialogs.addxp.levels.current_level")}</Label>
+              <Input
+                value={currentLevel}
+                onChange={(e) => setCurrentLevel(e.target.value)}
+                min={0}
+                max={10}
+                id="xp-current-level"
+                type="number"
+              />
+            </div>
+            <div className="flex items-center space-x-2 h-10">
+              <Checkbox
+                id="xp-compensate-boost"
+                checked={compensateBoost}
+                onCheckedChange={(value: boolean) => setCompensateBoost(value)}
+              />
+              <label htmlFor="xp-compensate-boost" className="text-sm font-medium leading-none">
+                {t("admin_panel.tabs.players.dialogs.addxp.levels.compensate_boost")}
+              </label>
+            </div>
+            {compensateBoost && (
+              <div className="space-y-1">
+                <Label htmlFor="xp-boost">{t("admin_panel.tabs.players.dialogs.addxp.levels.boost")}</Label>
+                <Input value={boost} onChange={(e) => setBoost(e.target.value)} min={0} max={3} id="xp-boost" type="number" />
+              </div>
+            )}
+            <Button onClick={handleApplyLevels} disabled={levelPreview.every((preview) => preview.xp <= 0)}>
+              {t("admin_panel.tabs.players.dialogs.addxp.levels.submit")}
+            </Button>
+          </div>
+          {levelPreview.length > 0 && (
+            <p className="text-sm text-muted-foreground">
+              {levelPreview
+                .map((preview) =>
+                  t("admin_panel.tabs.players.dialogs.addxp.levels.preview", {
+                    perk: preview.perk,
+                    from: preview.fromLevel,
+                    to: preview.toLevel,
+                    xp: preview.xp,
+                  })
+                )
+                .join(", ")}
+            </p>
+          )}
+        </div>
+
         <DialogFooter className="flex items-end w-full">
           <div className="space-y-1 w-full">
             <Label htmlFor="xp-amount" className="text-right">
@@ -0,0 +1,123 @@
+import { Button } from "@/components/ui/button";
+import { Badge } from "@/components/ui/badge";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { ScrollArea } from "@/components/ui/scroll-area";
+import { Input } from "../ui/input";
+import { GetBans, UnbanSteamIds, UnbanUsers } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { EventsOn } from "@/wailsjs/runtime/runtime";
+import { Search } from "lucide-react";
+import { useEffect, useMemo, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+interface BanListDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  onBanSteamIds: () => void;
+}
+
+export function BanListDialog({ isOpen, onClose, onBanSteamIds }: BanListDialogProps) {
+  const { t } = useTranslation();
+  const [bans, setBans] = useState<main.BanEntry[]>([]);
+  const [filter, setFilter] = useState("");
+
+  const refresh = () => GetBans().then(setBans);
+
+  useEffect(() => {
+    if (!isOpen) return;
+
+    setFilter("");
+    refresh();
+
+    // Bans and unbans of the dialogs and the terminal update the players, only this
+    // listener is removed so the player list keeps updating
+    return EventsOn("update-players", refresh);
+  }, [isOpen]);
+
+  const filteredBans = useMemo(() => {
+    const query = filter.trim().toLowerCase();
+    return bans.filter(
+      (ban) =>
+        query === "" ||
+        ban.name.toLowerCase().includes(query) ||
+        ban.steamId.includes(query) ||
+        ban.reason.toLowerCase().includes(query)
+    );
+  }, [bans, filter]);
+
+  const handleUnban = (ban: main.BanEntry) => {
+    if (ban.type === "steamid") {
+      UnbanSteamIds([ban.steamId]);
+    } else {
+      UnbanUsers([ban.name]);
+    }
+  };
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[40rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.players.dialogs.banlist.title")}</DialogTitle>
+          <DialogDescription>
+            <p>{t("admin_panel.tabs.players.dialogs.banlist.description", { n: bans.length })}</p>
+          </DialogDescription>
+        </DialogHeader>
+        <div className="relative">
+          <Input
+            placeholder={t("admin_panel.tabs.players.dialogs.banlist.filter")}
+            value={filter}
+            onChange={(e) => setFilter(e.target.value)}
+            className="pl-9 peer"
+          />
+          <div className="pointer-events-none absolute inset-y-0 start-0 flex items-center justify-center ps-3 text-muted-foreground/80">
+            <Search className="w-4 h-4" strokeWidth={2} />
+          </div>
+        </div>
+        <ScrollArea className="h-72 rounded-md border">
+          {filteredBans.length === 0 ? (
+            <p className="p-4 text-sm text-center text-muted-foreground">
+              {t("admin_panel.tabs.players.dialogs.banlist.empty")}
+            </p>
+          ) : (
+            filteredBans.map((ban) => (
+              <div key={`${ban.type}-${ban.name}-${ban.steamId}`} className="flex items-center gap-2 p-2 text-sm border-b">
+                <Badge variant={ban.type === "steamid" ? "default" : "outline"} className="shrink-0">
+                  {t(`admin_panel.tabs.players.dialogs.banlist.types.${ban.type}`)}
+                </Badge>
+                <div className="flex flex-col min-w-0">
+                  <span className="font-medium truncate">
+                    {ban.name || t("admin_panel.tabs.players.dialogs.banlist.unknown_player")}
+                  </span>
+                  <span className="text-xs text-muted-foreground truncate">
+                    {[
+                      ban.steamId,
+                      ban.reason,
+                      ban.created > 0 ? new Date(ban.created * 1000).toLocaleString() : "",
+                    ]
+                      .filter((part) => part !== "")
+                      .join(" · ")}
+                  </span>
+                </div>
+                <Button variant="outline" size="sm" className="ml-auto shrink-0" onClick={() => handleUnban(ban)}>
+                  {t("admin_panel.tabs.players.dialogs.unbanuser.button")}
+                </Button>
+              </div>
+            ))
+          )}
+        </ScrollArea>
+        <DialogFooter>
+          <Button variant="outline" onClick={onBanSteamIds}>
+            {t("admin_panel.tabs.players.dialogs.banlist.ban_steam_ids")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
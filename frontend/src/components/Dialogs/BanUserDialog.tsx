@@ -10,7 +10,9 @@ import {
 import { Label } from "@/components/ui/label";
 import { Checkbox } from "@/components/ui/checkbox";
 import { Textarea } from "../ui/textarea";
-import { BanUsers } from "@/wailsjs/go/main/App";
+import { Input } from "../ui/input";
+import { BanSteamIds, BanUsers } from "@/wailsjs/go/main/App";
+import { parseSteamIds } from "@/lib/utils";
 import { useEffect, useState } from "react";
 import { useTranslation } from "react-i18next";
 
@@ -24,16 +26,23 @@ export function BanUserDialog({ isOpen, onClose, names }: BanUserDialogProps) {
   const { t } = useTranslation();
   const [reason, setReason] = useState("");
   const [banIp, setBanIp] = useState(false);
+  const [steamIds, setSteamIds] = useState("");
 
   const handleBan = () => {
     onClose();
 
-    BanUsers(names, reason, banIp);
+    if (names.length > 0) {
+      BanUsers(names, reason, banIp);
+    }
+    if (parseSteamIds(steamIds).length > 0) {
+      BanSteamIds(parseSteamIds(steamIds), reason);
+    }
   };
 
   useEffect(() => {
     setReason("");
     setBanIp(false);
+    setSteamIds("");
   }, [isOpen]);
 
   return (
@@ -46,9 +55,22 @@ export function BanUserDialog({ isOpen, onClose, names }: BanUserDialogProps) {
               : t("admin_panel.tabs.players.dialogs.banuser.title")}
           </DialogTitle>
           <DialogDescription>
-            <p>{t("admin_panel.tabs.players.dialogs.banuser.players", { players: names.join(", ") })}</p>
+            {names.length > 0 && (
+              <p>{t("admin_panel.tabs.players.dialogs.banuser.players", { players: names.join(", ") })}</p>
+            )}
           </DialogDescription>
         </DialogHeader>
+        <div className="space-y-1">
+          <Label htmlFor="ban-steam-ids" className="text-right">
+            {t("admin_panel.tabs.players.dialogs.banuser.steam_ids")}
+          </Label>
+          <Input
+            value={steamIds}
+            onChange={(e) => setSteamIds(e.target.value)}
+            id="ban-steam-ids"
+            placeholder="76561198000000000"
+          />
+        </div>
         <div className="space-y-1">
           <Label htmlFor="ban-reason" className="text-right">
             {t("admin_panel.tabs.players.dialogs.banuser.reason")}
@@ -62,7 +84,12 @@ export function BanUserDialog({ isOpen, onClose, names }: BanUserDialogProps) {
           />
         </div>
         <div className="flex items-center space-x-2">
-          <Checkbox id="ban-ip" checked={banIp} onCheckedChange={(value: boolean) => setBanIp(value)} />
+          <Checkbox
+            id="ban-ip"
+            checked={banIp}
+            onCheckedChange={(value: boolean) => setBanIp(value)}
+            disabled={names.length === 0}
+          />
           <label
             htmlFor="ban-ip"
             className="text-sm font-medium leading-none peer-disabled:cursor-not-allowed peer-disabled:opacity-70"
@@ -71,7 +98,7 @@ export function BanUserDialog({ isOpen, onClose, names }: BanUserDialogProps) {
           </label>
         </div>
         <DialogFooter>
-          <Button type="submit" onClick={handleBan}>
+          <Button type="submit" onClick={handleBan} disabled={names.length === 0 && parseSteamIds(steamIds).length === 0}>
             {t("admin_panel.tabs.players.dialogs.banuser.submit")}
           </Button>
         </DialogFooter>
@@ -8,9 +8,14 @@ import {
   DialogTitle,
 } from "@/components/ui/dialog";
 import { Label } from "@/components/ui/label";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
 import { useEffect, useState } from "react";
 import { Input } from "../ui/input";
-import { CreateHorde } from "@/wailsjs/go/main/App";
+import { Textarea } from "../ui/textarea";
+import { CreateHorde, CreateHordeAt, GetHordeWavesStatus, StartHordeWaves, StopHordeWaves } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { EventsOff, EventsOn } from "@/wailsjs/runtime/runtime";
 import { useTranslation } from "react-i18next";
 
 interface CreateHordeDialogProps {
@@ -19,34 +24,202 @@ interface CreateHordeDialogProps {
   names: string[];
 }
 
+// parsePoints parses one "x,y[,z]" point or location name per line, returns null if a line is invalid
+function parsePoints(text: string): main.Coordinates[] | null {
+  const points: main.Coordinates[] = [];
+
+  for (const line of text.split("\n")) {
+    if (line.trim() === "") {
+      continue;
+    }
+
+    const parts = line.split(",").map((part) => part.trim());
+    if (parts.length === 1) {
+      points.push({ location: parts[0] } as main.Coordinates);
+      continue;
+    }
+    if (parts.length < 2 || parts.length > 3 || parts.some((part) => !/^-?\d+$/.test(part))) {
+      return null;
+    }
+
+    points.push({ x: parseInt(parts[0]), y: parseInt(parts[1]), z: parts.length === 3 ? parseInt(parts[2]) : 0 });
+  }
+
+  return points;
+}
+
 export function CreateHordeDialog({ isOpen, onClose, names }: CreateHordeDialogProps) {
   const { t } = useTranslation();
+  const [tab, setTab] = useState("players");
   const [count, setCount] = useState("");
+  const [points, setPoints] = useState("");
+  const [radius, setRadius] = useState("");
+  const [crawler, setCrawler] = useState(false);
+  const [fallen, setFallen] = useState(false);
+  const [waves, setWaves] = useState("1");
+  const [waveInterval, setWaveInterval] = useState("60");
+  const [wavesStatus, setWavesStatus] = useState({ running: false, waves: 0, results: [] } as main.HordeWavesStatus);
+  const [error, setError] = useState("");
 
-  const handleCreateHorde = () => {
-    onClose();
+  const parsedPoints = parsePoints(points);
+  const countValid = count !== "" && !isNaN(parseInt(count)) && parseInt(count) > 0 && parseFloat(count) % 1 === 0;
 
+  const handleCreateHorde = () => {
     // Check if count is a number
     if (isNaN(parseInt(count))) {
+      onClose();
+      return;
+    }
+
+    if (tab === "players") {
+      onClose();
+      CreateHorde(names, parseInt(count));
+      return;
+    }
+
+    if (!parsedPoints || parsedPoints.length === 0) {
       return;
     }
 
-    CreateHorde(names, parseInt(count));
+    const options = {
+      count: parseInt(count),
+      radius: parseInt(radius) || 0,
+      crawler: crawler,
+      fallen: fallen,
+    } as main.HordeOptions;
+
+    if (parsedPoints.length === 1 && (parseInt(waves) || 1) === 1) {
+      onClose();
+      CreateHordeAt(parsedPoints[0], options);
+      return;
+    }
+
+    StartHordeWaves({
+      points: parsedPoints,
+      waves: parseInt(waves) || 1,
+      interval: parseInt(waveInterval) || 0,
+      options: options,
+    } as main.HordeWaves).then((err) => setError(err));
   };
 
   useEffect(() => {
     setCount("");
+    setError("");
+    setTab(names.length > 0 ? "players" : "coordinates");
+
+    if (isOpen) {
+      GetHordeWavesStatus().then((status) => setWavesStatus(status));
+    }
   }, [isOpen]);
 
+  useEffect(() => {
+    EventsOn("horde-waves", (status: main.HordeWavesStatus) => {
+      setWavesStatus(status);
+    });
+
+    return () => {
+      EventsOff("horde-waves");
+    };
+  }, []);
+
   return (
     <Dialog open={isOpen} onOpenChange={onClose}>
       <DialogContent className="max-w-[28rem]">
         <DialogHeader>
           <DialogTitle>{t("admin_panel.tabs.players.dialogs.createhorde.title")}</DialogTitle>
           <DialogDescription>
-            <p>{t("admin_panel.tabs.players.dialogs.createhorde.players", { players: names.join(", ") })}</p>
+            {tab === "players" && (
+              <p>{t("admin_panel.tabs.players.dialogs.createhorde.players", { players: names.join(", ") })}</p>
+            )}
           </DialogDescription>
         </DialogHeader>
+        <Tabs value={tab}>
+          <TabsList className="grid w-full grid-cols-2">
+            <TabsTrigger value="players" disabled={names.length === 0} onClick={() => setTab("players")}>
+              {t("admin_panel.tabs.players.dialogs.createhorde.players_tab")}
+            </TabsTrigger>
+            <TabsTrigger value="coordinates" onClick={() => setTab("coordinates")}>
+              {t("admin_panel.tabs.players.dialogs.createhorde.coordinates_tab")}
+            </TabsTrigger>
+          </TabsList>
+          <TabsContent value="players" />
+          <TabsContent value="coordinates" className="space-y-3">
+            <div className="space-y-1">
+              <Label htmlFor="horde-points">{t("admin_panel.tabs.players.dialogs.createhorde.points")}</Label>
+              <Textarea
+                value={points}
+                onChange={(e) => setPoints(e.target.value)}
+                id="horde-points"
+                placeholder={"10617,9712,0\nMuldraugh"}
+                className="max-h-40"
+              />
+            </div>
+            <div className="grid grid-cols-3 gap-2">
+              <div className="space-y-1">
+                <Label htmlFor="horde-radius">{t("admin_panel.tabs.players.dialogs.createhorde.radius")}</Label>
+                <Input
+                  value={radius}
+                  onChange={(e) => setRadius(e.target.value)}
+                  min={0}
+                  id="horde-radius"
+                  type="number"
+                  placeholder="10"
+                />
+              </div>
+              <div className="space-y-1">
+                <Label htmlFor="horde-waves">{t("admin_panel.tabs.players.dialogs.createhorde.waves")}</Label>
+                <Input value={waves} onChange={(e) => setWaves(e.target.value)} min={1} id="horde-waves" type="number" />
+              </div>
+              <div className="space-y-1">
+                <Label htmlFor="horde-interval">{t("admin_panel.tabs.players.dialogs.createhorde.interval")}</Label>
+                <Input
+                  value={waveInterval}
+                  onChange={(e) => setWaveInterval(e.target.value)}
+                  min={1}
+                  id="horde-interval"
+                  type="number"
+                />
+              </div>
+            </div>
+            <div className="flex items-center gap-6">
+              <div className="flex items-center space-x-2">
+                <Checkbox id="horde-crawler" checked={crawler} onCheckedChange={(value: boolean) => setCrawler(value)} />
+                <label htmlFor="horde-crawler" className="text-sm font-medium leading-none">
+                  {t("admin_panel.tabs.players.dialogs.createhorde.crawler")}
+                </label>
+              </div>
+              <div className="flex items-center space-x-2">
+                <Checkbox id="horde-fallen" checked={fallen} onCheckedChange={(value: boolean) => setFallen(value)} />
+                <label htmlFor="horde-fallen" className="text-sm font-medium leading-none">
+                  {t("admin_panel.tabs.players.dialogs.createhorde.fallen")}
+                </label>
+              </div>
+            </div>
+            {parsedPoints === null && (
+              <p className="text-sm text-destructive">{t("admin_panel.tabs.players.dialogs.createhorde.invalid_points")}</p>
+            )}
+            {error && <p className="text-sm text-destructive">{error}</p>}
+            {wavesStatus.results.length > 0 && (
+              <div className="space-y-1 text-sm">
+                <p className="font-medium">
+                  {t("admin_panel.tabs.players.dialogs.createhorde.wave_progress", {
+                    n: wavesStatus.results.length,
+                    total: wavesStatus.waves,
+                  })}
+                </p>
+                {wavesStatus.results.map((result) => (
+                  <p key={result.wave} className={result.fail > 0 ? "text-destructive" : "text-muted-foreground"}>
+                    {t("admin_panel.tabs.players.dialogs.createhorde.wave_result", {
+                      wave: result.wave,
+                      s: result.success,
+                      f: result.fail,
+                    })}
+                  </p>
+                ))}
+              </div>
+            )}
+          </TabsContent>
+        </Tabs>
         <div className="space-y-1">
           <Label htmlFor="horde-size" className="text-right">
             {t("admin_panel.tabs.players.dialogs.createhorde.horde_size")}
@@ -70,10 +243,18 @@ export function CreateHordeDialog({ isOpen, onClose, names }: CreateHordeDialogP
           />
         </div>
         <DialogFooter>
+          {tab === "coordinates" && wavesStatus.running && (
+            <Button variant="destructive" onClick={() => StopHordeWaves()}>
+              {t("admin_panel.tabs.players.dialogs.createhorde.stop_waves")}
+            </Button>
+          )}
           <Button
             type="submit"
             onClick={handleCreateHorde}
-            disabled={count === "" || isNaN(parseInt(count)) || parseInt(count) < 0 || parseFloat(count) % 1 !== 0}
+            disabled={
+              !countValid ||
+              (tab === "coordinates" && (!parsedPoints || parsedPoints.length === 0 || wavesStatus.running))
+            }
           >
             {t("admin_panel.tabs.players.dialogs.createhorde.submit")}
           </Button>
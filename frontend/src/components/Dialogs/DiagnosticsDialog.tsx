@@ -0,0 +1,107 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { DiagnoseConnection } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { Check, LoaderCircle, MinusCircle, XCircle } from "lucide-react";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+interface DiagnosticsDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  credentials: main.Credentials | null;
+}
+
+function StepIcon({ status }: { status: string }) {
+  switch (status) {
+    case "ok":
+      return <Check className="w-4 h-4 text-green-500 shrink-0" />;
+    case "error":
+      return <XCircle className="w-4 h-4 text-destructive shrink-0" />;
+    default:
+      return <MinusCircle className="w-4 h-4 text-muted-foreground shrink-0" />;
+  }
+}
+
+export function DiagnosticsDialog({ isOpen, onClose, credentials }: DiagnosticsDialogProps) {
+  const { t } = useTranslation();
+  const [report, setReport] = useState<main.DiagnosticsReport | null>(null);
+  const [running, setRunning] = useState(false);
+
+  const runDiagnostics = () => {
+    if (!credentials) return;
+
+    setRunning(true);
+    setReport(null);
+    DiagnoseConnection(credentials).then((report) => {
+      setReport(report);
+      setRunning(false);
+    });
+  };
+
+  useEffect(() => {
+    if (isOpen) {
+      runDiagnostics();
+    } else {
+      setReport(null);
+    }
+  }, [isOpen]);
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[32rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.connection.diagnostics.title")}</DialogTitle>
+          <DialogDescription>
+            <p>{report?.address || `${credentials?.ip ?? ""}:${credentials?.port ?? ""}`}</p>
+          </DialogDescription>
+        </DialogHeader>
+
+        {running && (
+          <div className="flex justify-center py-4">
+            <LoaderCircle className="w-10 h-10 animate-spin" />
+          </div>
+        )}
+
+        {report && (
+          <div className="space-y-2">
+            {report.steps.map((step) => (
+              <div key={step.name} className="flex items-start gap-2 text-sm">
+                <StepIcon status={step.status} />
+                <div className="flex flex-col min-w-0">
+                  <span className="font-medium leading-4">
+                    {t(`admin_panel.tabs.connection.diagnostics.steps.${step.name}`)}
+                    {step.status !== "skipped" && (
+                      <span className="ml-2 text-xs text-muted-foreground">{step.durationMs} ms</span>
+                    )}
+                  </span>
+                  {step.message && <span className="text-muted-foreground break-all">{step.message}</span>}
+                </div>
+              </div>
+            ))}
+
+            {report.success ? (
+              <p className="text-sm text-green-500">{t("admin_panel.tabs.connection.diagnostics.success")}</p>
+            ) : (
+              report.suggestion && <p className="text-sm text-destructive">{t(report.suggestion)}</p>
+            )}
+          </div>
+        )}
+
+        <DialogFooter>
+          <Button variant="outline" onClick={runDiagnostics} disabled={running || !credentials}>
+            {t("admin_panel.tabs.connection.diagnostics.run")}
+          </Button>
+          <Button onClick={onClose}>{t("admin_panel.tabs.connection.diagnostics.close")}</Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
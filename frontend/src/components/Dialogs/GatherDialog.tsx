@@ -0,0 +1,144 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Gather, GetLastGather, GetLocations, ReturnGathered } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { useEffect, useState } from "react";
+import { Combobox } from "../ui/combobox";
+import { useTranslation } from "react-i18next";
+
+interface GatherDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  names: string[];
+}
+
+export function GatherDialog({ isOpen, onClose, names }: GatherDialogProps) {
+  const { t } = useTranslation();
+  const [target, setTarget] = useState({} as main.Coordinates);
+  const [columns, setColumns] = useState("");
+  const [spacing, setSpacing] = useState("1");
+  const [locations, setLocations] = useState([] as main.Location[]);
+  const [lastGather, setLastGather] = useState([] as main.GatheredPlayer[]);
+
+  const handleGather = () => {
+    onClose();
+
+    Gather(names, {
+      target: { ...target, z: target.z || 0 },
+      columns: parseInt(columns) || 0,
+      spacing: parseInt(spacing) || 1,
+    } as main.GatherOptions);
+  };
+
+  const handleReturn = () => {
+    onClose();
+    ReturnGathered();
+  };
+
+  useEffect(() => {
+    setTarget({} as main.Coordinates);
+
+    if (isOpen) {
+      GetLocations().then((locations) => setLocations(locations ?? []));
+      GetLastGather().then((lastGather) => setLastGather(lastGather ?? []));
+    }
+  }, [isOpen]);
+
+  const handleLocationChange = (name: string) => {
+    const location = locations.find((location) => location.name === name);
+    if (location) {
+      setTarget({ ...location.coordinates, location: "" } as main.Coordinates);
+    }
+  };
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[28rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.players.dialogs.gather.title")}</DialogTitle>
+          <DialogDescription>
+            <p>
+              {names.length > 0
+                ? t("admin_panel.tabs.players.dialogs.gather.players", { players: names.join(", ") })
+                : t("admin_panel.tabs.players.dialogs.gather.all_online")}
+            </p>
+          </DialogDescription>
+        </DialogHeader>
+        <Combobox
+          elements={locations.map((location) => ({
+            value: location.name,
+            label: location.category ? `${location.name} (${location.category})` : location.name,
+          }))}
+          placeholder={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.placeholder")}
+          searchPlaceholder={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.search_placeholder")}
+          nothingFoundMessage={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.search_not_found")}
+          onChange={handleLocationChange}
+        />
+        <div className="grid grid-cols-3 gap-10">
+          <div className="flex items-center gap-2">
+            <Label htmlFor="gather_x">X</Label>
+            <Input
+              value={target.x}
+              onChange={(e) => setTarget({ ...target, x: parseInt(e.target.value) })}
+              id="gather_x"
+              type="number"
+            />
+          </div>
+          <div className="flex items-center gap-2">
+            <Label htmlFor="gather_y">Y</Label>
+            <Input
+              value={target.y}
+              onChange={(e) => setTarget({ ...target, y: parseInt(e.target.value) })}
+              id="gather_y"
+              type="number"
+            />
+          </div>
+          <div className="flex items-center gap-2">
+            <Label htmlFor="gather_z">Z</Label>
+            <Input
+              value={target.z}
+              onChange={(e) => setTarget({ ...target, z: parseInt(e.target.value) })}
+              placeholder="0"
+              id="gather_z"
+              type="number"
+            />
+          </div>
+        </div>
+        <div className="grid grid-cols-2 gap-4">
+          <div className="space-y-1">
+            <Label htmlFor="gather-columns">{t("admin_panel.tabs.players.dialogs.gather.columns")}</Label>
+            <Input
+              value={columns}
+              onChange={(e) => setColumns(e.target.value)}
+              min={0}
+              id="gather-columns"
+              type="number"
+              placeholder={t("admin_panel.tabs.players.dialogs.gather.columns_placeholder")}
+            />
+          </div>
+          <div className="space-y-1">
+            <Label htmlFor="gather-spacing">{t("admin_panel.tabs.players.dialogs.gather.spacing")}</Label>
+            <Input value={spacing} onChange={(e) => setSpacing(e.target.value)} min={1} id="gather-spacing" type="number" />
+          </div>
+        </div>
+        <DialogFooter>
+          <Button variant="secondary" onClick={handleReturn} disabled={lastGather.length === 0}>
+            {t("admin_panel.tabs.players.dialogs.gather.return", { n: lastGather.length })}
+          </Button>
+          <Button type="submit" onClick={handleGather} disabled={!target.x || !target.y}>
+            {t("admin_panel.tabs.players.dialogs.gather.submit")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
@@ -0,0 +1,88 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { GetKits, GrantKit } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { useEffect, useState } from "react";
+import { Combobox } from "../ui/combobox";
+import { useTranslation } from "react-i18next";
+
+interface GrantKitDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  names: string[];
+}
+
+export function GrantKitDialog({ isOpen, onClose, names }: GrantKitDialogProps) {
+  const { t } = useTranslation();
+  const [kits, setKits] = useState([] as main.Kit[]);
+  const [kit, setKit] = useState("");
+  const [results, setResults] = useState([] as main.KitGrantResult[]);
+
+  const handleGrantKit = () => {
+    GrantKit(kit, names).then((results) => setResults(results ?? []));
+  };
+
+  useEffect(() => {
+    setKit("");
+    setResults([]);
+
+    if (isOpen) {
+      GetKits().then((kits) => setKits(kits ?? []));
+    }
+  }, [isOpen]);
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[28rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.players.dialogs.grantkit.title")}</DialogTitle>
+          <DialogDescription>
+            <p>{t("admin_panel.tabs.players.dialogs.grantkit.players", { players: names.join(", ") })}</p>
+          </DialogDescription>
+        </DialogHeader>
+        <Combobox
+          mandatory
+          elements={kits.map((kit) => ({
+            value: kit.name,
+            label: kit.name,
+          }))}
+          placeholder={t("admin_panel.tabs.players.dialogs.grantkit.kit_combobox.placeholder")}
+          searchPlaceholder={t("admin_panel.tabs.players.dialogs.grantkit.kit_combobox.search_placeholder")}
+          nothingFoundMessage={t("admin_panel.tabs.players.dialogs.grantkit.kit_combobox.search_not_found")}
+          onChange={setKit}
+        />
+        {results.length > 0 && (
+          <div className="space-y-1 text-sm max-h-48 overflow-y-auto">
+            {results.map((result) => (
+              <p
+                key={result.name}
+                className={result.success ? "text-muted-foreground" : result.skipped ? "" : "text-destructive"}
+              >
+                {result.success
+                  ? t("admin_panel.tabs.players.dialogs.grantkit.result_success", { name: result.name })
+                  : result.skipped
+                  ? t(`admin_panel.tabs.players.dialogs.grantkit.result_skipped_${result.skipped}`, { name: result.name })
+                  : t("admin_panel.tabs.players.dialogs.grantkit.result_fail", {
+                      name: result.name,
+                      error: result.errors.join(", "),
+                    })}
+              </p>
+            ))}
+          </div>
+        )}
+        <DialogFooter>
+          <Button type="submit" onClick={handleGrantKit} disabled={!kit}>
+            {t("admin_panel.tabs.players.dialogs.grantkit.submit")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
@@ -0,0 +1,193 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Label } from "@/components/ui/label";
+import { ScrollArea } from "@/components/ui/scroll-area";
+import { ExportWhitelistCredentialsDialog, ImportWhitelist, LoadWhitelistImportDialog } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { Check, FolderOpen, XCircle } from "lucide-react";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { Checkbox } from "../ui/checkbox";
+import { Input } from "../ui/input";
+
+const minPasswordLength = 8;
+const defaultPasswordLength = 12;
+
+interface ImportWhitelistDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+}
+
+export function ImportWhitelistDialog({ isOpen, onClose }: ImportWhitelistDialogProps) {
+  const { t } = useTranslation();
+  const [entries, setEntries] = useState<main.WhitelistEntry[]>([]);
+  const [passwordLength, setPasswordLength] = useState(defaultPasswordLength);
+  const [results, setResults] = useState<main.WhitelistImportResult[]>([]);
+  const [importing, setImporting] = useState(false);
+  const [protectExport, setProtectExport] = useState(false);
+  const [zipPassword, setZipPassword] = useState("");
+
+  const handleLoadFile = () => {
+    LoadWhitelistImportDialog().then((entries) => {
+      if (entries) {
+        setEntries(entries);
+        setResults([]);
+      }
+    });
+  };
+
+  const handleImport = () => {
+    setImporting(true);
+    ImportWhitelist(entries, passwordLength).then((results) => {
+      setResults(results);
+      setImporting(false);
+    });
+  };
+
+  const handleExport = () => {
+    ExportWhitelistCredentialsDialog(results, protectExport ? zipPassword : "");
+  };
+
+  useEffect(() => {
+    setEntries([]);
+    setResults([]);
+    setImporting(false);
+    setPasswordLength(defaultPasswordLength);
+    setProtectExport(false);
+    setZipPassword("");
+  }, [isOpen]);
+
+  const imported = results.length > 0;
+  const successCount = results.filter((result) => result.success).length;
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[36rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.players.dialogs.importwhitelist.title")}</DialogTitle>
+          <DialogDescription>
+            <p>{t("admin_panel.tabs.players.dialogs.importwhitelist.description")}</p>
+          </DialogDescription>
+        </DialogHeader>
+
+        {!imported ? (
+          <div className="space-y-4">
+            <div className="flex items-center gap-2">
+              <Button variant="outline" onClick={handleLoadFile} disabled={importing}>
+                <FolderOpen className="w-4 h-4 mr-2" />
+                {t("admin_panel.tabs.players.dialogs.importwhitelist.load_file")}
+              </Button>
+              {entries.length > 0 && (
+                <span className="text-sm text-muted-foreground">
+                  {t("admin_panel.tabs.players.dialogs.importwhitelist.entries", { n: entries.length })}
+                </span>
+              )}
+            </div>
+
+            {entries.length > 0 && (
+              <ScrollArea className="h-48 rounded-md border p-2">
+                {entries.map((entry) => (
+                  <div key={entry.username} className="flex justify-between text-sm">
+                    <span>{entry.username}</span>
+                    <span className="text-muted-foreground">
+                      {entry.password
+                        ? "••••••••"
+                        : t("admin_panel.tabs.players.dialogs.importwhitelist.generated_password")}
+                    </span>
+                  </div>
+                ))}
+              </ScrollArea>
+            )}
+
+            <div className="space-y-1">
+              <Label htmlFor="import-password-length">
+                {t("admin_panel.tabs.players.dialogs.importwhitelist.password_length")}
+              </Label>
+              <Input
+                id="import-password-length"
+                type="number"
+                inputMode="numeric"
+                min={minPasswordLength}
+                max={64}
+                value={passwordLength}
+                onChange={(e) => setPasswordLength(parseInt(e.target.value) || minPasswordLength)}
+                onBlur={() => setPasswordLength((length) => Math.min(64, Math.max(minPasswordLength, length)))}
+              />
+            </div>
+          </div>
+        ) : (
+          <div className="space-y-4">
+            <p className="text-sm">
+              {t("admin_panel.tabs.players.dialogs.importwhitelist.result", {
+                s: successCount,
+                n: results.length,
+              })}
+            </p>
+            <ScrollArea className="h-48 rounded-md border p-2">
+              {results.map((result) => (
+                <div key={result.username} className="flex items-center gap-2 text-sm">
+                  {result.success ? (
+                    <Check className="w-4 h-4 text-green-500 shrink-0" />
+                  ) : (
+                    <XCircle className="w-4 h-4 text-destructive shrink-0" />
+                  )}
+                  <span className="shrink-0">{result.username}</span>
+                  <span className="ml-auto text-muted-foreground break-all text-right">
+                    {result.success ? result.password : result.error}
+                  </span>
+                </div>
+              ))}
+            </ScrollArea>
+
+            {successCount > 0 && (
+              <div className="space-y-2">
+                <div className="flex items-center space-x-2">
+                  <Checkbox
+                    id="import-protect-export"
+                    checked={protectExport}
+                    onCheckedChange={(state: boolean) => setProtectExport(state)}
+                  />
+                  <label htmlFor="import-protect-export" className="text-sm font-medium leading-none">
+                    {t("admin_panel.tabs.players.dialogs.importwhitelist.protect_export")}
+                  </label>
+                </div>
+                {protectExport && (
+                  <Input
+                    type="password"
+                    placeholder={t("admin_panel.tabs.players.dialogs.importwhitelist.zip_password")}
+                    value={zipPassword}
+                    onChange={(e) => setZipPassword(e.target.value)}
+                  />
+                )}
+              </div>
+            )}
+          </div>
+        )}
+
+        <DialogFooter>
+          {!imported ? (
+            <Button type="submit" onClick={handleImport} disabled={entries.length === 0 || importing}>
+              {t("admin_panel.tabs.players.dialogs.importwhitelist.submit", { n: entries.length })}
+            </Button>
+          ) : (
+            <>
+              <Button variant="outline" onClick={onClose}>
+                {t("admin_panel.tabs.players.dialogs.importwhitelist.close")}
+              </Button>
+              <Button onClick={handleExport} disabled={successCount === 0 || (protectExport && !zipPassword)}>
+                {t("admin_panel.tabs.players.dialogs.importwhitelist.export")}
+              </Button>
+            </>
+          )}
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
@@ -0,0 +1,154 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
+import { RemoveZombies } from "@/wailsjs/go/main/App";
+import { main } from "@/wailsjs/go/models";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+interface RemoveZombiesDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  names: string[];
+}
+
+export function RemoveZombiesDialog({ isOpen, onClose, names }: RemoveZombiesDialogProps) {
+  const { t } = useTranslation();
+  const [tab, setTab] = useState("players");
+  const [coordinates, setCoordinates] = useState({} as main.Coordinates);
+  const [radius, setRadius] = useState("");
+  const [reanimated, setReanimated] = useState(false);
+
+  const handleRemoveZombies = () => {
+    onClose();
+
+    const options = {
+      radius: parseInt(radius) || 0,
+      reanimated: reanimated,
+    } as main.RemoveZombiesOptions;
+
+    if (tab === "coordinates") {
+      options.coordinates = { ...coordinates, z: coordinates.z || 0 };
+      RemoveZombies([], options);
+    } else {
+      RemoveZombies(names, options);
+    }
+  };
+
+  useEffect(() => {
+    setCoordinates({} as main.Coordinates);
+    setRadius("");
+    setReanimated(false);
+    setTab(names.length > 0 ? "players" : "coordinates");
+  }, [isOpen]);
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[28rem]">
+        <DialogHeader>
+          <DialogTitle>{t("admin_panel.tabs.players.dialogs.removezombies.title")}</DialogTitle>
+          <DialogDescription>
+            {tab === "players" && (
+              <p>{t("admin_panel.tabs.players.dialogs.removezombies.players", { players: names.join(", ") })}</p>
+            )}
+          </DialogDescription>
+        </DialogHeader>
+        <Tabs value={tab}>
+          <TabsList className="grid w-full grid-cols-2">
+            <TabsTrigger value="players" disabled={names.length === 0} onClick={() => setTab("players")}>
+              {t("admin_panel.tabs.players.dialogs.removezombies.players_tab")}
+            </TabsTrigger>
+            <TabsTrigger value="coordinates" onClick={() => setTab("coordinates")}>
+              {t("admin_panel.tabs.players.dialogs.removezombies.coordinates_tab")}
+            </TabsTrigger>
+          </TabsList>
+          <TabsContent value="players" />
+          <TabsContent value="coordinates">
+            <div className="grid grid-cols-3 gap-10 pt-4 pb-2">
+              <div className="flex items-center gap-2">
+                <Label htmlFor="removezombies_x">X</Label>
+                <Input
+                  value={coordinates.x}
+                  onChange={(e) => setCoordinates({ ...coordinates, x: parseInt(e.target.value) })}
+                  id="removezombies_x"
+                  type="number"
+                />
+              </div>
+              <div className="flex items-center gap-2">
+                <Label htmlFor="removezombies_y">Y</Label>
+                <Input
+                  value={coordinates.y}
+                  onChange={(e) => setCoordinates({ ...coordinates, y: parseInt(e.target.value) })}
+                  id="removezombies_y"
+                  type="number"
+                />
+              </div>
+              <div className="flex items-center gap-2">
+                <Label htmlFor="removezombies_z">Z</Label>
+                <Input
+                  value={coordinates.z}
+                  onChange={(e) => setCoordinates({ ...coordinates, z: parseInt(e.target.value) })}
+                  placeholder="0"
+                  id="removezombies_z"
+                  type="number"
+                />
+              </div>
+            </div>
+          </TabsContent>
+        </Tabs>
+        <div className="space-y-1">
+          <Label htmlFor="removezombies-radius">{t("admin_panel.tabs.players.dialogs.removezombies.radius")}</Label>
+          <Input
+            value={radius}
+            onChange={(e) => {
+              const parsedValue = parseInt(e.target.value);
+
+              if (!isNaN(parsedValue)) {
+                setRadius(Math.max(0, Math.min(parsedValue, 1000)).toString());
+              } else {
+                setRadius(e.target.value);
+              }
+            }}
+            min={0}
+            max={1000}
+            id="removezombies-radius"
+            type="number"
+            placeholder="20"
+          />
+        </div>
+        <div className="flex items-center space-x-2">
+          <Checkbox
+            id="removezombies-reanimated"
+            checked={reanimated}
+            onCheckedChange={(value: boolean) => setReanimated(value)}
+          />
+          <label
+            htmlFor="removezombies-reanimated"
+            className="text-sm font-medium leading-none peer-disabled:cursor-not-allowed peer-disabled:opacity-70"
+          >
+            {t("admin_panel.tabs.players.dialogs.removezombies.reanimated")}
+          </label>
+        </div>
+        <DialogFooter>
+          <Button
+            type="submit"
+            onClick={handleRemoveZombies}
+            disabled={tab === "coordinates" && (!coordinates.x || !coordinates.y)}
+          >
+            {t("admin_panel.tabs.players.dialogs.removezombies.submit")}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
@@ -10,7 +10,7 @@ import {
 import { Input } from "@/components/ui/input";
 import { Label } from "@/components/ui/label";
 import { Tabs, TabsContent, TabsList, TabsTrigger } from "@/components/ui/tabs";
-import { TeleportToCoordinates, TeleportToUser } from "@/wailsjs/go/main/App";
+import { GetLocations, TeleportToCoordinates, TeleportToUser } from "@/wailsjs/go/main/App";
 import { main } from "@/wailsjs/go/models";
 import { BrowserOpenURL } from "@/wailsjs/runtime/runtime";
 import { useEffect, useState } from "react";
@@ -30,6 +30,7 @@ export function TeleportDialog({ isOpen, onClose, names }: TeleportDialogProps)
   const [coordinates, setCoordinates] = useState({} as main.Coordinates);
   const [player, setPlayer] = useState("");
   const { players } = useRcon();
+  const [locations, setLocations] = useState([] as main.Location[]);
 
   const handleTeleport = () => {
     onClose();
@@ -44,8 +45,19 @@ export function TeleportDialog({ isOpen, onClose, names }: TeleportDialogProps)
   useEffect(() => {
     setCoordinates({} as main.Coordinates);
     setTab("coordinates");
+
+    if (isOpen) {
+      GetLocations().then((locations) => setLocations(locations ?? []));
+    }
   }, [isOpen]);
 
+  const handleLocationChange = (name: string) => {
+    const location = locations.find((location) => location.name === name);
+    if (location) {
+      setCoordinates({ ...location.coordinates, location: "" } as main.Coordinates);
+    }
+  };
+
   const handlePlayerChange = (player: string) => {
     setCoordinates({} as main.Coordinates);
     setPlayer(player);
@@ -74,8 +86,20 @@ export function TeleportDialog({ isOpen, onClose, names }: TeleportDialogProps)
               {t("admin_panel.tabs.players.dialogs.teleport.player_tab")}
             </TabsTrigger>
           </TabsList>
-          <TabsContent value="coordinates" className="h-24">
-            <div className="grid grid-cols-3 gap-10 pt-6 pb-2">
+          <TabsContent value="coordinates" className="h-36">
+            <div className="pt-4">
+              <Combobox
+                elements={locations.map((location) => ({
+                  value: location.name,
+                  label: location.category ? `${location.name} (${location.category})` : location.name,
+                }))}
+                placeholder={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.placeholder")}
+                searchPlaceholder={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.search_placeholder")}
+                nothingFoundMessage={t("admin_panel.tabs.players.dialogs.teleport.location_combobox.search_not_found")}
+                onChange={handleLocationChange}
+              />
+            </div>
+            <div className="grid grid-cols-3 gap-10 pt-4 pb-2">
               <div className="flex items-center gap-2">
                 <Label htmlFor="teleport_x">X</Label>
                 <Input
@@ -7,7 +7,11 @@ import {
   DialogHeader,
   DialogTitle,
 } from "@/components/ui/dialog";
-import { UnbanUsers } from "@/wailsjs/go/main/App";
+import { Label } from "@/components/ui/label";
+import { Input } from "../ui/input";
+import { GetBans, UnbanSteamIds, UnbanUsers } from "@/wailsjs/go/main/App";
+import { parseSteamIds } from "@/lib/utils";
+import { useEffect, useState } from "react";
 import { useTranslation } from "react-i18next";
 
 interface UnbanUserDialogProps {
@@ -18,12 +22,34 @@ interface UnbanUserDialogProps {
 
 export function UnbanUserDialog({ isOpen, onClose, names }: UnbanUserDialogProps) {
   const { t } = useTranslation();
+  const [steamIds, setSteamIds] = useState("");
+
   const handleBan = () => {
     onClose();
 
-    UnbanUsers(names);
+    if (names.length > 0) {
+      UnbanUsers(names);
+    }
+    if (parseSteamIds(steamIds).length > 0) {
+      UnbanSteamIds(parseSteamIds(steamIds));
+    }
   };
 
+  // Steam ID bans of the selected players are lifted together with their username bans
+  useEffect(() => {
+    setSteamIds("");
+    if (!isOpen || names.length === 0) return;
+
+    GetBans().then((bans) => {
+      setSteamIds(
+        bans
+          .filter((ban) => ban.type === "steamid" && names.includes(ban.name))
+          .map((ban) => ban.steamId)
+          .join(", ")
+      );
+    });
+  }, [isOpen]);
+
   return (
     <Dialog open={isOpen} onOpenChange={onClose}>
       <DialogContent className="max-w-[28rem]">
@@ -34,11 +60,28 @@ export function UnbanUserDialog({ isOpen, onClose, names }: UnbanUserDialogProps
               : t("admin_panel.tabs.players.dialogs.unbanuser.title")}
           </DialogTitle>
           <DialogDescription>
-            <p>{t("admin_panel.tabs.players.dialogs.unbanuser.players", { players: names.join(", ") })}</p>
+            {names.length > 0 && (
+              <p>{t("admin_panel.tabs.players.dialogs.unbanuser.players", { players: names.join(", ") })}</p>
+            )}
           </DialogDescription>
         </DialogHeader>
+        <div className="space-y-1">
+          <Label htmlFor="unban-steam-ids" className="text-right">
+            {t("admin_panel.tabs.players.dialogs.unbanuser.steam_ids")}
+          </Label>
+          <Input
+            value={steamIds}
+            onChange={(e) => setSteamIds(e.target.value)}
+            id="unban-steam-ids"
+            placeholder="76561198000000000"
+          />
+        </div>
         <DialogFooter>
-          <Button type="submit" onClick={handleBan}>
+          <Button
+            type="submit"
+            onClick={handleBan}
+            disabled={names.length === 0 && parseSteamIds(steamIds).length === 0}
+          >
             {t("admin_panel.tabs.players.dialogs.unbanuser.submit")}
           </Button>
         </DialogFooter>
@@ -0,0 +1,101 @@
+import { Button } from "@/components/ui/button";
+import {
+  Dialog,
+  DialogContent,
+  DialogDescription,
+  DialogFooter,
+  DialogHeader,
+  DialogTitle,
+} from "@/components/ui/dialog";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import { CreateVault, UnlockVault } from "@/wailsjs/go/main/App";
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+
+const minPasswordLength = 8;
+
+interface VaultDialogProps {
+  isOpen: boolean;
+  onClose: () => void;
+  mode: "create" | "unlock";
+  onSuccess: () => void;
+}
+
+export function VaultDialog({ isOpen, onClose, mode, onSuccess }: VaultDialogProps) {
+  const { t } = useTranslation();
+  const [password, setPassword] = useState("");
+  const [confirmPassword, setConfirmPassword] = useState("");
+  const [working, setWorking] = useState(false);
+
+  const valid =
+    mode === "unlock" ? password.length > 0 : password.length >= minPasswordLength && password === confirmPassword;
+
+  const handleSubmit = () => {
+    if (!valid) return;
+
+    setWorking(true);
+    (mode === "create" ? CreateVault(password) : UnlockVault(password)).then((success) => {
+      setWorking(false);
+      if (success) {
+        onClose();
+        onSuccess();
+      }
+    });
+  };
+
+  useEffect(() => {
+    setPassword("");
+    setConfirmPassword("");
+    setWorking(false);
+  }, [isOpen]);
+
+  return (
+    <Dialog open={isOpen} onOpenChange={onClose}>
+      <DialogContent className="max-w-[28rem]">
+        <DialogHeader>
+          <DialogTitle>{t(`admin_panel.tabs.connection.vault.${mode}_title`)}</DialogTitle>
+          <DialogDescription>
+            <p>{t(`admin_panel.tabs.connection.vault.${mode}_description`)}</p>
+          </DialogDescription>
+        </DialogHeader>
+        <div className="space-y-1">
+          <Label htmlFor="vault-password">{t("admin_panel.tabs.connection.vault.master_password")}</Label>
+          <Input
+            id="vault-password"
+            type="password"
+            value={password}
+            onChange={(e) => setPassword(e.target.value)}
+            onKeyDown={(e) => e.key === "Enter" && handleSubmit()}
+            autoFocus
+          />
+        </div>
+        {mode === "create" && (
+          <div className="space-y-1">
+            <Label htmlFor="vault-confirm-password">{t("admin_panel.tabs.connection.vault.confirm_password")}</Label>
+            <Input
+              id="vault-confirm-password"
+              type="password"
+              value={confirmPassword}
+              onChange={(e) => setConfirmPassword(e.target.value)}
+              onKeyDown={(e) => e.key === "Enter" && handleSubmit()}
+            />
+            {password.length > 0 && password.length < minPasswordLength && (
+              <p className="text-sm text-destructive">
+                {t("admin_panel.tabs.connection.vault.password_too_short", { n: minPasswordLength })}
+              </p>
+            )}
+            {confirmPassword.length > 0 && password !== confirmPassword && (
+              <p className="text-sm text-destructive">{t("admin_panel.tabs.connection.vault.passwords_dont_match")}</p>
+            )}
+          </div>
+        )}
+        <DialogFooter>
+          <Button type="submit" onClick={handleSubmit} disabled={!valid || working}>
+            {t(`admin_panel.tabs.connection.vault.${mode}`)}
+          </Button>
+        </DialogFooter>
+      </DialogContent>
+    </Dialog>
+  );
+}
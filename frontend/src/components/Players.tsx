@@ -34,20 +34,25 @@ import { useState } from "react";
 import { BanUserDialog } from "./Dialogs/BanUserDialog";
 import { useRcon } from "@/contexts/rcon-provider";
 import { UnbanUserDialog } from "./Dialogs/UnbanUserDialog";
+import { BanListDialog } from "./Dialogs/BanListDialog";
 import { KickUserDialog } from "./Dialogs/KickUserDialog";
-import { GodMode } from "@/wailsjs/go/main/App";
+import { GodMode, Invisible, Noclip, VoiceBan } from "@/wailsjs/go/main/App";
 import { TeleportDialog } from "./Dialogs/TeleportDialog";
+import { GatherDialog } from "./Dialogs/GatherDialog";
 import { SetAccessLevelDialog } from "./Dialogs/SetAccessLevelDialog";
 import { Badge } from "./ui/badge";
 import { AddPlayerDialog } from "./Dialogs/AddPlayerDialog";
 import { CreateHordeDialog } from "./Dialogs/CreateHordeDialog";
+import { RemoveZombiesDialog } from "./Dialogs/RemoveZombiesDialog";
 import { LightningDialog } from "./Dialogs/LightningDialog";
 import { ThunderDialog } from "./Dialogs/ThunderDialog";
 import { AddPlayerToWhitelistDialog } from "./Dialogs/AddPlayerToWhitelistDialog";
+import { ImportWhitelistDialog } from "./Dialogs/ImportWhitelistDialog";
 import { RemovePlayerFromWhitelistDialog } from "./Dialogs/RemovePlayerFromWhitelistDialog";
 import { AddXpDialog } from "./Dialogs/AddXpDialog";
 import { AddVehicleDialog } from "./Dialogs/AddVehicleDialog";
 import { AddItemDialog } from "./Dialogs/AddItemDialog";
+import { GrantKitDialog } from "./Dialogs/GrantKitDialog";
 import { useConfig } from "@/contexts/config-provider";
 import { useTranslation } from "react-i18next";
 
@@ -109,6 +114,7 @@ export function PlayersTab() {
       cell: ({ row }) => {
         const online = row.getValue("online");
         const banned = row.getValue("banned");
+        const voiceBanned = row.original.voiceBanned;
         return (
           <div className="space-x-1">
             <Badge
@@ -128,6 +134,14 @@ export function PlayersTab() {
             ) : (
               ""
             )}
+
+            {voiceBanned ? (
+              <Badge className="bg-warning text-warning-foreground">
+                {t("admin_panel.tabs.players.columns.status.voice_banned")}
+              </Badge>
+            ) : (
+              ""
+            )}
           </div>
         );
       },
@@ -209,6 +223,13 @@ export function PlayersTab() {
                       {t("admin_panel.tabs.players.dialogs.unbanuser.button")}
                     </DropdownMenuItem>
                   )}
+                  <DropdownMenuItem
+                    className="flex justify-between"
+                    onClick={() => handleVoiceBan(!player.voiceBanned, player.name)}
+                  >
+                    {t("admin_panel.tabs.players.voice_ban")}
+                    {player.voiceBanned && <Check />}
+                  </DropdownMenuItem>
                   {player.online && (
                     <DropdownMenuItem onClick={() => handleKick(player.name)}>
                       {t("admin_panel.tabs.players.dialogs.kickuser.button")}
@@ -235,6 +256,22 @@ export function PlayersTab() {
                         {t("admin_panel.tabs.players.god_mode")}
                         {player.godmode && <Check />}
                       </DropdownMenuItem>
+                      <DropdownMenuItem
+                        disabled={!config?.debugMode && !player.online}
+                        className="flex justify-between"
+                        onClick={() => Invisible([player.name], !player.invisible)}
+                      >
+                        {t("admin_panel.tabs.players.invisible")}
+                        {player.invisible && <Check />}
+                      </DropdownMenuItem>
+                      <DropdownMenuItem
+                        disabled={!config?.debugMode && !player.online}
+                        className="flex justify-between"
+                        onClick={() => Noclip([player.name], !player.noclip)}
+                      >
+                        {t("admin_panel.tabs.players.noclip")}
+                        {player.noclip && <Check />}
+                      </DropdownMenuItem>
                       <DropdownMenuItem
                         disabled={!config?.debugMode && !player.online}
                         onClick={() => handleTeleport(player.name)}
@@ -258,6 +295,12 @@ export function PlayersTab() {
                       >
                         {t("admin_panel.tabs.players.dialogs.additem.button")}
                       </DropdownMenuItem>
+                      <DropdownMenuItem
+                        disabled={!config?.debugMode && !player.online}
+                        onClick={() => handleGrantKit(player.name)}
+                      >
+                        {t("admin_panel.tabs.players.dialogs.grantkit.button")}
+                      </DropdownMenuItem>
                       <DropdownMenuItem
                         disabled={!config?.debugMode && !player.online}
                         onClick={() => handleAddVehicle(player.name)}
@@ -280,6 +323,9 @@ export function PlayersTab() {
                           <DropdownMenuItem onClick={() => handleCreateHorde(player.name)}>
                             {t("admin_panel.tabs.players.dialogs.createhorde.button")}
                           </DropdownMenuItem>
+                          <DropdownMenuItem onClick={() => handleRemoveZombies(player.name)}>
+                            {t("admin_panel.tabs.players.dialogs.removezombies.button")}
+                          </DropdownMenuItem>
                           <DropdownMenuItem onClick={() => handleLightning(player.name)}>
                             {t("admin_panel.tabs.players.dialogs.lightning.button")}
                           </DropdownMenuItem>
@@ -351,6 +397,13 @@ export function PlayersTab() {
     setUnbanDialogOpen(true);
   };
 
+  const [isBanListDialogOpen, setBanListDialogOpen] = useState(false);
+  const handleBanSteamIds = () => {
+    setBanListDialogOpen(false);
+    setSelectedUsers([]);
+    setBanDialogOpen(true);
+  };
+
   const [isKickDialogOpen, setKickDialogOpen] = useState(false);
   const handleKick = (name?: string) => {
     handleSelect(name);
@@ -368,12 +421,34 @@ export function PlayersTab() {
     GodMode(selectedUsers, value);
   };
 
+  const handleVoiceBan = (value: boolean, name: string) => {
+    VoiceBan([name], value);
+  };
+
+  const [isGrantKitDialogOpen, setGrantKitDialogOpen] = useState(false);
+  const handleGrantKit = (name?: string) => {
+    handleSelect(name);
+    setGrantKitDialogOpen(true);
+  };
+
+  const [isGatherDialogOpen, setGatherDialogOpen] = useState(false);
+  const handleGather = () => {
+    handleSelect();
+    setGatherDialogOpen(true);
+  };
+
   const [isCreateHordeDialogOpen, setCreateHordeDialogOpen] = useState(false);
   const handleCreateHorde = (name?: string) => {
     handleSelect(name);
     setCreateHordeDialogOpen(true);
   };
 
+  const [isRemoveZombiesDialogOpen, setRemoveZombiesDialogOpen] = useState(false);
+  const handleRemoveZombies = (name?: string) => {
+    handleSelect(name);
+    setRemoveZombiesDialogOpen(true);
+  };
+
   const [isLightningDialogOpen, setLightningDialogOpen] = useState(false);
   const handleLightning = (name?: string) => {
     handleSelect(name);
@@ -388,6 +463,8 @@ export function PlayersTab() {
 
   const [isAddPlayerToWhitelistDialogOpen, setAddPlayerToWhitelistDialogOpen] = useState(false);
 
+  const [isImportWhitelistDialogOpen, setImportWhitelistDialogOpen] = useState(false);
+
   const [isRemovePlayerFromWhitelistDialogOpen, setRemovePlayerFromWhitelistDialogOpen] = useState(false);
   const handleRemovePlayerFromWhitelist = (name?: string) => {
     handleSelect(name);
@@ -439,6 +516,13 @@ export function PlayersTab() {
                   >
                     {t("admin_panel.tabs.players.dialogs.addplayertowhitelist.button")}
                   </Button>
+                  <Button
+                    onClick={() => {
+                      setImportWhitelistDialogOpen(true);
+                    }}
+                  >
+                    {t("admin_panel.tabs.players.dialogs.importwhitelist.button")}
+                  </Button>
                   <Button
                     onClick={() => {
                       handleRemovePlayerFromWhitelist();
@@ -477,6 +561,14 @@ export function PlayersTab() {
                   {t("admin_panel.tabs.players.dialogs.unbanuser.button")}
                 </Button>
 
+                <Button
+                  onClick={() => {
+                    setBanListDialogOpen(true);
+                  }}
+                >
+                  {t("admin_panel.tabs.players.dialogs.banlist.button")}
+                </Button>
+
                 <Button
                   onClick={() => {
                     handleKick();
@@ -509,6 +601,14 @@ export function PlayersTab() {
                   {t("admin_panel.tabs.players.dialogs.teleport.button")}
                 </Button>
 
+                <Button
+                  onClick={() => {
+                    handleGather();
+                  }}
+                >
+                  {t("admin_panel.tabs.players.dialogs.gather.button")}
+                </Button>
+
                 <Button
                   onClick={() => {
                     handleCreateHorde();
@@ -525,6 +625,22 @@ export function PlayersTab() {
                   {t("admin_panel.tabs.players.dialogs.createhorde.button")}
                 </Button>
 
+                <Button
+                  onClick={() => {
+                    handleRemoveZombies();
+                  }}
+                  disabled={
+                    !debug &&
+                    (Object.keys(rowSelection).length === 0 ||
+                      !table
+                        .getSelectedRowModel()
+                        .rows.map((row) => row.original)
+                        .some((player) => player.online))
+                  }
+                >
+                  {t("admin_panel.tabs.players.dialogs.removezombies.button")}
+                </Button>
+
                 <Button
                   onClick={() => {
                     handleLightning();
@@ -589,6 +705,22 @@ export function PlayersTab() {
                   {t("admin_panel.tabs.players.dialogs.additem.button")}
                 </Button>
 
+                <Button
+                  onClick={() => {
+                    handleGrantKit();
+                  }}
+                  disabled={
+                    !debug &&
+                    (Object.keys(rowSelection).length === 0 ||
+                      !table
+                        .getSelectedRowModel()
+                        .rows.map((row) => row.original)
+                        .some((player) => player.online))
+                  }
+                >
+                  {t("admin_panel.tabs.players.dialogs.grantkit.button")}
+                </Button>
+
                 <Button
                   onClick={() => {
                     handleAddVehicle();
@@ -683,17 +815,29 @@ export function PlayersTab() {
         }
       />
       <UnbanUserDialog isOpen={isUnbanDialogOpen} onClose={() => setUnbanDialogOpen(false)} names={selectedUsers} />
+      <BanListDialog
+        isOpen={isBanListDialogOpen}
+        onClose={() => setBanListDialogOpen(false)}
+        onBanSteamIds={handleBanSteamIds}
+      />
       <KickUserDialog isOpen={isKickDialogOpen} onClose={() => setKickDialogOpen(false)} names={selectedUsers} />
       <TeleportDialog
         isOpen={isTeleportDialogOpen}
         onClose={() => setTeleportDialogOpen(false)}
         names={selectedUsers}
       />
+      <GrantKitDialog isOpen={isGrantKitDialogOpen} onClose={() => setGrantKitDialogOpen(false)} names={selectedUsers} />
+      <GatherDialog isOpen={isGatherDialogOpen} onClose={() => setGatherDialogOpen(false)} names={selectedUsers} />
       <CreateHordeDialog
         isOpen={isCreateHordeDialogOpen}
         onClose={() => setCreateHordeDialogOpen(false)}
         names={selectedUsers}
       />
+      <RemoveZombiesDialog
+        isOpen={isRemoveZombiesDialogOpen}
+        onClose={() => setRemoveZombiesDialogOpen(false)}
+        names={selectedUsers}
+      />
       <LightningDialog
         isOpen={isLightningDialogOpen}
         onClose={() => setLightningDialogOpen(false)}
@@ -718,6 +862,10 @@ export function PlayersTab() {
         isOpen={isAddPlayerToWhitelistDialogOpen}
         onClose={() => setAddPlayerToWhitelistDialogOpen(false)}
       />
+      <ImportWhitelistDialog
+        isOpen={isImportWhitelistDialogOpen}
+        onClose={() => setImportWhitelistDialogOpen(false)}
+      />
       <RemovePlayerFromWhitelistDialog
         isOpen={isRemovePlayerFromWhitelistDialogOpen}
         onClose={() => setRemovePlayerFromWhitelistDialogOpen(false)}
@@ -0,0 +1,53 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { SettingsItem, SettingContent, SettingDescription, SettingLabel } from "@/components/ui/settings-group";
+import { Input } from "@/components/ui/input";
+import { useConfig } from "@/contexts/config-provider";
+
+export function AnalyticsRetentionSetting() {
+  const { config, setConfigField } = useConfig();
+  const { t } = useTranslation();
+  const [{ isLoading, analyticsRetentionDays }, setState] = useState({
+    isLoading: true,
+    analyticsRetentionDays: "",
+  });
+
+  useEffect(() => {
+    if (isLoading && config?.analyticsRetentionDays !== undefined) {
+      setState({
+        isLoading: false,
+        analyticsRetentionDays: config.analyticsRetentionDays.toString(),
+      });
+    }
+  }, [isLoading, config?.analyticsRetentionDays]);
+
+  const handleAnalyticsRetentionDaysChange = (textValue: string) => {
+    const parsedValue = parseInt(textValue);
+    const value = isNaN(parsedValue) ? 365 : Math.max(0, Math.min(3650, parsedValue));
+    setConfigField("analyticsRetentionDays", value);
+    setState((prevState) => ({
+      ...prevState,
+      analyticsRetentionDays: textValue === "" ? "" : value.toString(),
+    }));
+  };
+
+  return (
+    <SettingsItem loading={isLoading} configKey="analyticsRetentionDays">
+      <div>
+        <SettingLabel>{t("settings.setting.analytics_retention_days.label")}</SettingLabel>
+        <SettingDescription>{`${t("settings.setting.analytics_retention_days.description")}`}</SettingDescription>
+      </div>
+      <SettingContent>
+        <Input
+          type="number"
+          placeholder="365"
+          value={analyticsRetentionDays}
+          onChange={(e) => handleAnalyticsRetentionDaysChange(e.target.value)}
+          min={0}
+          max={3650}
+          onKeyDown={(e) => e.key.match(/[-+]/) && e.preventDefault()}
+        />
+      </SettingContent>
+    </SettingsItem>
+  );
+}
@@ -0,0 +1,72 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { SettingsItem, SettingContent, SettingDescription, SettingLabel } from "@/components/ui/settings-group";
+import { Input } from "@/components/ui/input";
+import { Switch } from "@/components/ui/switch";
+import { useConfig } from "@/contexts/config-provider";
+import { ApplyMetricsConfig } from "@/wailsjs/go/main/App";
+
+export function MetricsSetting() {
+  const { config, setConfigField } = useConfig();
+  const { t } = useTranslation();
+  const [{ isLoading, enableMetrics, metricsAddress }, setState] = useState({
+    isLoading: true,
+    enableMetrics: false,
+    metricsAddress: "",
+  });
+
+  useEffect(() => {
+    if (isLoading && config?.enableMetrics !== undefined && config?.metricsAddress !== undefined) {
+      setState({
+        isLoading: false,
+        enableMetrics: config.enableMetrics,
+        metricsAddress: config.metricsAddress,
+      });
+    }
+  }, [isLoading, config?.enableMetrics, config?.metricsAddress]);
+
+  // The server is restarted on the new address, a failed start turns the switch back off
+  const applyMetrics = (enabled: boolean) => {
+    ApplyMetricsConfig().then((success) => {
+      if (!success && enabled) {
+        setConfigField("enableMetrics", false);
+        setState((prevState) => ({ ...prevState, enableMetrics: false }));
+      }
+    });
+  };
+
+  const handleSwitch = (value: boolean) => {
+    setState((prevState) => ({ ...prevState, enableMetrics: value }));
+    setConfigField("enableMetrics", value).then(() => applyMetrics(value));
+  };
+
+  const handleAddressCommit = () => {
+    const value = metricsAddress.trim() || "127.0.0.1:9810";
+    setState((prevState) => ({ ...prevState, metricsAddress: value }));
+    if (value === config?.metricsAddress) return;
+
+    setConfigField("metricsAddress", value).then(() => enableMetrics && applyMetrics(true));
+  };
+
+  return (
+    <SettingsItem loading={isLoading} configKey={["enableMetrics", "metricsAddress"]}>
+      <div>
+        <SettingLabel>{t("settings.setting.metrics.label")}</SettingLabel>
+        <SettingDescription>{t("settings.setting.metrics.description")}</SettingDescription>
+      </div>
+      <SettingContent className="gap-2">
+        <Input
+          className="w-44"
+          placeholder="127.0.0.1:9810"
+          aria-label={t("settings.setting.metrics.address")}
+          title={t("settings.setting.metrics.address")}
+          value={metricsAddress}
+          onChange={(e) => setState((prevState) => ({ ...prevState, metricsAddress: e.target.value }))}
+          onBlur={handleAddressCommit}
+          onKeyDown={(e) => e.key === "Enter" && handleAddressCommit()}
+        />
+        <Switch checked={enableMetrics} onCheckedChange={() => handleSwitch(!enableMetrics)} />
+      </SettingContent>
+    </SettingsItem>
+  );
+}
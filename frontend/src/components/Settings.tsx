@@ -18,6 +18,8 @@ import { useEffect, useState } from "react";
 import { useStorage } from "@/contexts/storage-provider";
 import { ColorSchemeSetting } from "./SettingItems/ColorSchemeSetting";
 import { RCONCheckIntervalSetting } from "./SettingItems/RCONCheckIntervalSetting";
+import { MetricsSetting } from "./SettingItems/MetricsSetting";
+import { AnalyticsRetentionSetting } from "./SettingItems/AnalyticsRetentionSetting";
 import { ScrollArea } from "./ui/scroll-area";
 import { DisableWeatherControlsSetting } from "./SettingItems/DisableWeatherControlsSetting";
 import { DisableRandomButtonsSetting } from "./SettingItems/DisableRandomButtonsSetting";
@@ -98,6 +100,7 @@ export default function Settings() {
         <ScrollArea className="h-full w-full overflow-auto">
           <SettingsGroup className="flex flex-col items-start px-4 py-2 w-full h-full">
             <RCONCheckIntervalSetting />
+            <AnalyticsRetentionSetting />
             <DisableWeatherControlsSetting />
             <DisableRandomButtonsSetting />
             <DisableOtherButtonsSetting />
@@ -118,6 +121,7 @@ export default function Settings() {
             <EnableLoggingSetting />
             <LogLevelSetting />
             <MaxLogFilesSetting />
+            <MetricsSetting />
             <ImportExportSetting />
             {process.env.NODE_ENV === "development" && <DebugModeSetting />}
           </SettingsGroup>
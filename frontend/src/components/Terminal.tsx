@@ -211,6 +211,7 @@ const TerminalPage: React.FC = () => {
     godmode: playerNames,
     invisible: playerNames,
     noclip: playerNames,
+    voiceban: playerNames,
     changeoption: options,
   };
 
@@ -16,7 +16,7 @@ interface ConfigContextType {
   config: main.Config | null;
   initialConfig: main.Config | null;
   setConfig: React.Dispatch<React.SetStateAction<main.Config | null>>;
-  setConfigField: (key: keyof main.Config, value: any) => void;
+  setConfigField: (key: keyof main.Config, value: any) => Promise<void>;
 }
 
 // Create the context
@@ -41,12 +41,12 @@ export const ConfigProvider: React.FC<{ children: ReactNode }> = ({
       });
   }, []);
 
-  const setConfigField = (key: keyof main.Config, value: any) => {
+  const setConfigField = async (key: keyof main.Config, value: any) => {
     if (config) {
       var strKey = key as string;
       strKey = strKey.charAt(0).toUpperCase() + strKey.slice(1);
       // Call the backend function to set the config field
-      SetConfigField_backend(strKey, value)
+      return SetConfigField_backend(strKey, value)
         .then(() => {
           // Update the config state with the new value
           setConfig((prevConfig) => {
@@ -13,6 +13,7 @@ import {
   ConnectRcon,
   DisconnectRcon,
   ExportOptionsDialog,
+  GetPzOptionTypes,
   ImportOptionsDialog,
   SendRconCommand,
   UpdatePzOptions,
@@ -20,7 +21,7 @@ import {
 import { main } from "@/wailsjs/go/models";
 import { EventsOff, EventsOn } from "@/wailsjs/runtime/runtime";
 import { deepEqual } from "@/lib/utils";
-import { optionsMap } from "@/assets/options";
+import { OptionValue, PzOptions, optionsMap } from "@/assets/options";
 
 interface RconContextType {
   isConnected: boolean;
@@ -33,9 +34,10 @@ interface RconContextType {
   port: string;
   players: main.Player[];
 
-  options: main.PzOptions;
-  modifiedOptions: main.PzOptions;
-  modifyOption: (key: keyof main.PzOptions, value: main.PzOptions[keyof main.PzOptions]) => void;
+  options: PzOptions;
+  modifiedOptions: PzOptions;
+  optionTypes: Record<string, string>;
+  modifyOption: (key: string, value: OptionValue) => void;
   cancelModifiedOptions: () => void;
   optionsModified: boolean;
   updateOptions: (reload?: boolean) => Promise<boolean>;
@@ -58,8 +60,9 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
   const [port, setPort] = useState("");
   const [players, setPlayers] = useState<main.Player[]>([]);
 
-  const [options, setOptions] = useState<main.PzOptions>({} as main.PzOptions);
-  const [modifiedOptions, setModifiedOptions] = useState<main.PzOptions>({} as main.PzOptions);
+  const [options, setOptions] = useState<PzOptions>({} as PzOptions);
+  const [modifiedOptions, setModifiedOptions] = useState<PzOptions>({} as PzOptions);
+  const [optionTypes, setOptionTypes] = useState<Record<string, string>>({});
   const [updatingOptions, setUpdatingOptions] = useState(false);
 
   const [reloadDoubleOptionsKey, setReloadDoubleOptionsKey] = useState(0);
@@ -82,9 +85,10 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
       setPlayers(players);
     };
 
-    const handleUpdateOptions = (newOptions: main.PzOptions) => {
+    const handleUpdateOptions = (newOptions: PzOptions) => {
       setModifiedOptions(newOptions);
       setOptions(newOptions);
+      GetPzOptionTypes().then(setOptionTypes);
     };
 
     EventsOn("update-players", handleUpdatePlayers);
@@ -119,8 +123,9 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
       const result = await DisconnectRcon();
       setIsConnected(!result);
       setPlayers([]);
-      setOptions({} as main.PzOptions);
-      setModifiedOptions({} as main.PzOptions);
+      setOptions({} as PzOptions);
+      setModifiedOptions({} as PzOptions);
+      setOptionTypes({});
       setIp("");
       setPort("");
       setUpdatingOptions(false);
@@ -147,14 +152,14 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
     [isConnected]
   );
 
-  const modifyOption = useCallback((key: keyof main.PzOptions, value: main.PzOptions[keyof main.PzOptions]) => {
+  const modifyOption = useCallback((key: string, value: OptionValue) => {
     setModifiedOptions((prevOptions) => ({ ...prevOptions, [key]: value }));
   }, []);
 
   const updateOptions: RconContextType["updateOptions"] = useCallback(
     async (reload) => {
       setUpdatingOptions(true);
-      const success = await UpdatePzOptions(modifiedOptions, reload ?? false);
+      const success = await UpdatePzOptions(modifiedOptions as main.PzOptions, reload ?? false);
       setUpdatingOptions(false);
 
       return success;
@@ -163,7 +168,7 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
   );
 
   const cancelModifiedOptions = useCallback(() => {
-    setModifiedOptions(options as main.PzOptions);
+    setModifiedOptions(options as PzOptions);
   }, [options]);
 
   useEffect(() => {
@@ -179,14 +184,15 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
 
   const importOptions = () => {
     ImportOptionsDialog().then((response) => {
+      // Imported files may miss options, e.g. of other game versions, so they keep their value
       if (response.success) {
-        setModifiedOptions(response.options);
+        setModifiedOptions((prevOptions) => ({ ...prevOptions, ...(response.options as PzOptions) }));
       }
     });
   };
 
   const exportOptions = () => {
-    ExportOptionsDialog(modifiedOptions);
+    ExportOptionsDialog(modifiedOptions as main.PzOptions);
   };
 
   return (
@@ -203,6 +209,7 @@ export const RconProvider: React.FC<{ children: ReactNode }> = ({ children }) =>
         players,
         options,
         modifiedOptions,
+        optionTypes,
         optionsModified,
         modifyOption,
         cancelModifiedOptions,
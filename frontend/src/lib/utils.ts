@@ -59,3 +59,13 @@ export function formatString(string: string, ...args: any[]): string {
   string = string.replace(new RegExp("<<percent>>", "g"), "%");
   return string;
 }
+
+/**
+ * Splits a list of Steam IDs separated by whitespace, commas or semicolons.
+ *
+ * @param text - The text the Steam IDs were entered in.
+ * @returns The unique Steam IDs, validated by the backend.
+ */
+export function parseSteamIds(text: string): string[] {
+  return [...new Set(text.split(/[\s,;]+/).filter((steamId) => steamId !== ""))];
+}
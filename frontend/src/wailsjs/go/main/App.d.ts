@@ -4,8 +4,14 @@ import {main} from '../models';
 
 export function AddItems(arg1:Array<string>,arg2:Array<main.ItemRecord>):Promise<void>;
 
+export function AddPerkLevels(arg1:Array<string>,arg2:main.PerkLevelRequest):Promise<void>;
+
 export function AddPlayer(arg1:string):Promise<void>;
 
+export function AddPlayerNote(arg1:string,arg2:string,arg3:string):Promise<boolean>;
+
+export function AddPlayerTag(arg1:string,arg2:string):Promise<boolean>;
+
 export function AddPlayerToWhitelist(arg1:string,arg2:string):Promise<void>;
 
 export function AddVehicle(arg1:string,arg2:Array<string>,arg3:main.Coordinates):Promise<void>;
@@ -14,46 +20,136 @@ export function AddXp(arg1:Array<string>,arg2:Array<string>,arg3:number):Promise
 
 export function Alarm():Promise<void>;
 
+export function ApplyMetricsConfig():Promise<boolean>;
+
+export function BanSteamIds(arg1:Array<string>,arg2:string):Promise<void>;
+
 export function BanUsers(arg1:Array<string>,arg2:string,arg3:boolean):Promise<void>;
 
+export function ChangeVaultPassword(arg1:string,arg2:string):Promise<boolean>;
+
 export function CheckForUpdate():Promise<main.UpdateInfo>;
 
+export function CheckItemIds(arg1:Array<string>):Promise<Array<main.CatalogIdCheck>>;
+
 export function CheckModsNeedUpdate():Promise<void>;
 
+export function CheckVehicleId(arg1:string):Promise<main.CatalogIdCheck>;
+
 export function Chopper():Promise<void>;
 
+export function ClearModCatalog():Promise<boolean>;
+
 export function ConnectRcon(arg1:main.Credentials):Promise<boolean>;
 
 export function CopyToClipboard(arg1:string,arg2:boolean):Promise<void>;
 
 export function CreateHorde(arg1:Array<string>,arg2:number):Promise<void>;
 
-export function DeleteCredentials():Promise<boolean>;
+export function CreateHordeAt(arg1:main.Coordinates,arg2:main.HordeOptions):Promise<void>;
+
+export function CreateVault(arg1:string):Promise<boolean>;
+
+export function DeleteCredentials(arg1:main.Credentials):Promise<boolean>;
+
+export function DeleteKit(arg1:string):Promise<boolean>;
+
+export function DeleteLocation(arg1:string):Promise<boolean>;
+
+export function DeletePlayerGroup(arg1:string):Promise<boolean>;
+
+export function DeletePlayerNote(arg1:string,arg2:string):Promise<boolean>;
+
+export function DeletePlayerTag(arg1:string):Promise<boolean>;
+
+export function DeleteVaultCredentials(arg1:main.Credentials):Promise<boolean>;
+
+export function DiagnoseConnection(arg1:main.Credentials):Promise<main.DiagnosticsReport>;
 
 export function DisconnectRcon():Promise<boolean>;
 
+export function ExportLocationsDialog():Promise<void>;
+
 export function ExportOptionsDialog(arg1:main.PzOptions):Promise<void>;
 
+export function ExportWhitelistCredentialsDialog(arg1:Array<main.WhitelistImportResult>,arg2:string):Promise<void>;
+
+export function FetchServerDatabase(arg1:string):Promise<main.ServerDatabaseImport>;
+
 export function Format(arg1:string,arg2:Array<any>):Promise<string>;
 
+export function Gather(arg1:Array<string>,arg2:main.GatherOptions):Promise<number>;
+
 export function GetArch():Promise<string>;
 
+export function GetBans():Promise<Array<main.BanEntry>>;
+
 export function GetConfig():Promise<main.Config>;
 
 export function GetConfigField(arg1:string):Promise<any>;
 
+export function GetConnectionHealth():Promise<main.ConnectionHealth>;
+
+export function GetHordeWavesStatus():Promise<main.HordeWavesStatus>;
+
+export function GetItemCategories():Promise<Array<string>>;
+
+export function GetKits():Promise<Array<main.Kit>>;
+
+export function GetLastGather():Promise<Array<main.GatheredPlayer>>;
+
 export function GetLoadConfigPath():Promise<string>;
 
+export function GetLocations():Promise<Array<main.Location>>;
+
+export function GetModCatalog():Promise<main.ModCatalog>;
+
 export function GetOs():Promise<string>;
 
+export function GetPlayerGroups():Promise<Array<main.PlayerGroup>>;
+
+export function GetPlayerTags():Promise<Array<main.PlayerTag>>;
+
+export function GetPlayersByTags(arg1:Array<string>,arg2:boolean):Promise<Array<main.Player>>;
+
+export function GetPopulationSeries(arg1:string,arg2:number):Promise<Array<main.PopulationBucket>>;
+
+export function GetPopulationSummary(arg1:number):Promise<main.PopulationSummary>;
+
+export function GetPzOptionTypes():Promise<Record<string, string>>;
+
+export function GetStarterKit():Promise<main.StarterKit>;
+
+export function GetStarterKitGrants():Promise<Array<main.StarterKitGrant>>;
+
+export function GetVaultCredentials():Promise<Array<main.Credentials>>;
+
+export function GetVaultStatus():Promise<main.VaultStatus>;
+
+export function GetVehicleCategories():Promise<Array<string>>;
+
 export function GetVersion():Promise<string>;
 
 export function GodMode(arg1:Array<string>,arg2:boolean):Promise<void>;
 
+export function GrantKit(arg1:string,arg2:Array<string>):Promise<Array<main.KitGrantResult>>;
+
 export function Gunshot():Promise<void>;
 
+export function ImportLocationsDialog():Promise<number>;
+
+export function ImportModCatalogDialog():Promise<number>;
+
 export function ImportOptionsDialog():Promise<main.ImportOptionsResponse>;
 
+export function ImportServerDatabase(arg1:main.ServerDatabase):Promise<number>;
+
+export function ImportWhitelist(arg1:Array<main.WhitelistEntry>,arg2:number):Promise<Array<main.WhitelistImportResult>>;
+
+export function Invisible(arg1:Array<string>,arg2:boolean):Promise<void>;
+
+export function IsMetricsServerRunning():Promise<boolean>;
+
 export function IsRconConnected():Promise<boolean>;
 
 export function KickUsers(arg1:Array<string>,arg2:string):Promise<void>;
@@ -66,12 +162,22 @@ export function LoadItemsDialog():Promise<Array<main.ItemRecord>>;
 
 export function LoadMessageDialog():Promise<main.ServerMessage>;
 
+export function LoadServerDatabaseDialog():Promise<main.ServerDatabaseImport>;
+
+export function LoadWhitelistImportDialog():Promise<Array<main.WhitelistEntry>>;
+
+export function LockVault():Promise<void>;
+
+export function Noclip(arg1:Array<string>,arg2:boolean):Promise<void>;
+
 export function OpenFileInExplorer(arg1:string):Promise<void>;
 
 export function OpenLogFolder():Promise<void>;
 
 export function Players():Promise<Array<main.Player>>;
 
+export function PreviewPerkLevels(arg1:main.PerkLevelRequest,arg2:boolean):Promise<Array<main.PerkXpPreview>>;
+
 export function RandomLightning():Promise<void>;
 
 export function RandomThunder():Promise<void>;
@@ -80,20 +186,44 @@ export function ReadConfig(arg1:string):Promise<void>;
 
 export function ReloadOptions():Promise<void>;
 
+export function RemovePlayerTag(arg1:string,arg2:string):Promise<boolean>;
+
 export function RemovePlayersFromWhitelist(arg1:Array<string>,arg2:boolean):Promise<number>;
 
+export function RemoveZombies(arg1:Array<string>,arg2:main.RemoveZombiesOptions):Promise<void>;
+
+export function ResolvePlayerGroup(arg1:string):Promise<Array<string>>;
+
 export function RestartApplication(arg1:Array<string>):Promise<void>;
 
+export function RestoreBuiltinLocations():Promise<number>;
+
+export function ReturnGathered():Promise<number>;
+
 export function SaveConfigDialog():Promise<void>;
 
 export function SaveCredentials(arg1:main.Credentials):Promise<boolean>;
 
 export function SaveItemsDialog(arg1:Array<main.ItemRecord>):Promise<void>;
 
+export function SaveKit(arg1:main.Kit,arg2:string):Promise<string>;
+
+export function SaveLocation(arg1:main.Location,arg2:string):Promise<string>;
+
 export function SaveMessagesDialog(arg1:main.ServerMessage):Promise<void>;
 
+export function SavePlayerGroup(arg1:main.PlayerGroup):Promise<string>;
+
+export function SavePlayerTag(arg1:main.PlayerTag):Promise<boolean>;
+
+export function SaveStarterKit(arg1:main.StarterKit):Promise<string>;
+
 export function SaveWorld():Promise<void>;
 
+export function SearchItems(arg1:main.CatalogQuery):Promise<main.CatalogPage>;
+
+export function SearchVehicles(arg1:main.CatalogQuery):Promise<main.CatalogPage>;
+
 export function SendNotification(arg1:main.Notification):Promise<void>;
 
 export function SendRconCommand(arg1:string):Promise<main.RconResponse>;
@@ -106,10 +236,22 @@ export function SetAccessLevel(arg1:Array<string>,arg2:string):Promise<void>;
 
 export function SetConfigField(arg1:string,arg2:any):Promise<void>;
 
+export function SetPerkLevel(arg1:Array<string>,arg2:main.PerkLevelRequest):Promise<void>;
+
+export function SetPlayerCustomField(arg1:string,arg2:string,arg3:string):Promise<boolean>;
+
+export function SetPlayerSteamId(arg1:string,arg2:string):Promise<boolean>;
+
+export function SetPlayerTags(arg1:string,arg2:Array<string>):Promise<boolean>;
+
+export function StartHordeWaves(arg1:main.HordeWaves):Promise<string>;
+
 export function StartRain(arg1:number):Promise<void>;
 
 export function StartStorm(arg1:number):Promise<void>;
 
+export function StopHordeWaves():Promise<void>;
+
 export function StopRain():Promise<void>;
 
 export function StopServer():Promise<boolean>;
@@ -122,8 +264,16 @@ export function TeleportToUser(arg1:Array<string>,arg2:string):Promise<void>;
 
 export function Thunder(arg1:Array<string>):Promise<void>;
 
+export function UnbanSteamIds(arg1:Array<string>):Promise<void>;
+
 export function UnbanUsers(arg1:Array<string>):Promise<void>;
 
+export function UnlockVault(arg1:string):Promise<boolean>;
+
 export function Update(arg1:string):Promise<void>;
 
 export function UpdatePzOptions(arg1:main.PzOptions,arg2:boolean):Promise<boolean>;
+
+export function ValidateGroupRule(arg1:string):Promise<string>;
+
+export function VoiceBan(arg1:Array<string>,arg2:boolean):Promise<void>;
@@ -6,10 +6,22 @@ export function AddItems(arg1, arg2) {
   return window['go']['main']['App']['AddItems'](arg1, arg2);
 }
 
+export function AddPerkLevels(arg1, arg2) {
+  return window['go']['main']['App']['AddPerkLevels'](arg1, arg2);
+}
+
 export function AddPlayer(arg1) {
   return window['go']['main']['App']['AddPlayer'](arg1);
 }
 
+export function AddPlayerNote(arg1, arg2, arg3) {
+  return window['go']['main']['App']['AddPlayerNote'](arg1, arg2, arg3);
+}
+
+export function AddPlayerTag(arg1, arg2) {
+  return window['go']['main']['App']['AddPlayerTag'](arg1, arg2);
+}
+
 export function AddPlayerToWhitelist(arg1, arg2) {
   return window['go']['main']['App']['AddPlayerToWhitelist'](arg1, arg2);
 }
@@ -26,22 +38,46 @@ export function Alarm() {
   return window['go']['main']['App']['Alarm']();
 }
 
+export function ApplyMetricsConfig() {
+  return window['go']['main']['App']['ApplyMetricsConfig']();
+}
+
+export function BanSteamIds(arg1, arg2) {
+  return window['go']['main']['App']['BanSteamIds'](arg1, arg2);
+}
+
 export function BanUsers(arg1, arg2, arg3) {
   return window['go']['main']['App']['BanUsers'](arg1, arg2, arg3);
 }
 
+export function ChangeVaultPassword(arg1, arg2) {
+  return window['go']['main']['App']['ChangeVaultPassword'](arg1, arg2);
+}
+
 export function CheckForUpdate() {
   return window['go']['main']['App']['CheckForUpdate']();
 }
 
+export function CheckItemIds(arg1) {
+  return window['go']['main']['App']['CheckItemIds'](arg1);
+}
+
 export function CheckModsNeedUpdate() {
   return window['go']['main']['App']['CheckModsNeedUpdate']();
 }
 
+export function CheckVehicleId(arg1) {
+  return window['go']['main']['App']['CheckVehicleId'](arg1);
+}
+
 export function Chopper() {
   return window['go']['main']['App']['Chopper']();
 }
 
+export function ClearModCatalog() {
+  return window['go']['main']['App']['ClearModCatalog']();
+}
+
 export function ConnectRcon(arg1) {
   return window['go']['main']['App']['ConnectRcon'](arg1);
 }
@@ -54,26 +90,82 @@ export function CreateHorde(arg1, arg2) {
   return window['go']['main']['App']['CreateHorde'](arg1, arg2);
 }
 
-export function DeleteCredentials() {
-  return window['go']['main']['App']['DeleteCredentials']();
+export function CreateHordeAt(arg1, arg2) {
+  return window['go']['main']['App']['CreateHordeAt'](arg1, arg2);
+}
+
+export function CreateVault(arg1) {
+  return window['go']['main']['App']['CreateVault'](arg1);
+}
+
+export function DeleteCredentials(arg1) {
+  return window['go']['main']['App']['DeleteCredentials'](arg1);
+}
+
+export function DeleteKit(arg1) {
+  return window['go']['main']['App']['DeleteKit'](arg1);
+}
+
+export function DeleteLocation(arg1) {
+  return window['go']['main']['App']['DeleteLocation'](arg1);
+}
+
+export function DeletePlayerGroup(arg1) {
+  return window['go']['main']['App']['DeletePlayerGroup'](arg1);
+}
+
+export function DeletePlayerNote(arg1, arg2) {
+  return window['go']['main']['App']['DeletePlayerNote'](arg1, arg2);
+}
+
+export function DeletePlayerTag(arg1) {
+  return window['go']['main']['App']['DeletePlayerTag'](arg1);
+}
+
+export function DeleteVaultCredentials(arg1) {
+  return window['go']['main']['App']['DeleteVaultCredentials'](arg1);
+}
+
+export function DiagnoseConnection(arg1) {
+  return window['go']['main']['App']['DiagnoseConnection'](arg1);
 }
 
 export function DisconnectRcon() {
   return window['go']['main']['App']['DisconnectRcon']();
 }
 
+export function ExportLocationsDialog() {
+  return window['go']['main']['App']['ExportLocationsDialog']();
+}
+
 export function ExportOptionsDialog(arg1) {
   return window['go']['main']['App']['ExportOptionsDialog'](arg1);
 }
 
+export function ExportWhitelistCredentialsDialog(arg1, arg2) {
+  return window['go']['main']['App']['ExportWhitelistCredentialsDialog'](arg1, arg2);
+}
+
+export function FetchServerDatabase(arg1) {
+  return window['go']['main']['App']['FetchServerDatabase'](arg1);
+}
+
 export function Format(arg1, arg2) {
   return window['go']['main']['App']['Format'](arg1, arg2);
 }
 
+export function Gather(arg1, arg2) {
+  return window['go']['main']['App']['Gather'](arg1, arg2);
+}
+
 export function GetArch() {
   return window['go']['main']['App']['GetArch']();
 }
 
+export function GetBans() {
+  return window['go']['main']['App']['GetBans']();
+}
+
 export function GetConfig() {
   return window['go']['main']['App']['GetConfig']();
 }
@@ -82,14 +174,86 @@ export function GetConfigField(arg1) {
   return window['go']['main']['App']['GetConfigField'](arg1);
 }
 
+export function GetConnectionHealth() {
+  return window['go']['main']['App']['GetConnectionHealth']();
+}
+
+export function GetHordeWavesStatus() {
+  return window['go']['main']['App']['GetHordeWavesStatus']();
+}
+
+export function GetItemCategories() {
+  return window['go']['main']['App']['GetItemCategories']();
+}
+
+export function GetKits() {
+  return window['go']['main']['App']['GetKits']();
+}
+
+export function GetLastGather() {
+  return window['go']['main']['App']['GetLastGather']();
+}
+
 export function GetLoadConfigPath() {
   return window['go']['main']['App']['GetLoadConfigPath']();
 }
 
+export function GetLocations() {
+  return window['go']['main']['App']['GetLocations']();
+}
+
+export function GetModCatalog() {
+  return window['go']['main']['App']['GetModCatalog']();
+}
+
 export function GetOs() {
   return window['go']['main']['App']['GetOs']();
 }
 
+export function GetPlayerGroups() {
+  return window['go']['main']['App']['GetPlayerGroups']();
+}
+
+export function GetPlayerTags() {
+  return window['go']['main']['App']['GetPlayerTags']();
+}
+
+export function GetPlayersByTags(arg1, arg2) {
+  return window['go']['main']['App']['GetPlayersByTags'](arg1, arg2);
+}
+
+export function GetPopulationSeries(arg1, arg2) {
+  return window['go']['main']['App']['GetPopulationSeries'](arg1, arg2);
+}
+
+export function GetPopulationSummary(arg1) {
+  return window['go']['main']['App']['GetPopulationSummary'](arg1);
+}
+
+export function GetPzOptionTypes() {
+  return window['go']['main']['App']['GetPzOptionTypes']();
+}
+
+export function GetStarterKit() {
+  return window['go']['main']['App']['GetStarterKit']();
+}
+
+export function GetStarterKitGrants() {
+  return window['go']['main']['App']['GetStarterKitGrants']();
+}
+
+export function GetVaultCredentials() {
+  return window['go']['main']['App']['GetVaultCredentials']();
+}
+
+export function GetVaultStatus() {
+  return window['go']['main']['App']['GetVaultStatus']();
+}
+
+export function GetVehicleCategories() {
+  return window['go']['main']['App']['GetVehicleCategories']();
+}
+
 export function GetVersion() {
   return window['go']['main']['App']['GetVersion']();
 }
@@ -98,14 +262,42 @@ export function GodMode(arg1, arg2) {
   return window['go']['main']['App']['GodMode'](arg1, arg2);
 }
 
+export function GrantKit(arg1, arg2) {
+  return window['go']['main']['App']['GrantKit'](arg1, arg2);
+}
+
 export function Gunshot() {
   return window['go']['main']['App']['Gunshot']();
 }
 
+export function ImportLocationsDialog() {
+  return window['go']['main']['App']['ImportLocationsDialog']();
+}
+
+export function ImportModCatalogDialog() {
+  return window['go']['main']['App']['ImportModCatalogDialog']();
+}
+
 export function ImportOptionsDialog() {
   return window['go']['main']['App']['ImportOptionsDialog']();
 }
 
+export function ImportServerDatabase(arg1) {
+  return window['go']['main']['App']['ImportServerDatabase'](arg1);
+}
+
+export function ImportWhitelist(arg1, arg2) {
+  return window['go']['main']['App']['ImportWhitelist'](arg1, arg2);
+}
+
+export function Invisible(arg1, arg2) {
+  return window['go']['main']['App']['Invisible'](arg1, arg2);
+}
+
+export function IsMetricsServerRunning() {
+  return window['go']['main']['App']['IsMetricsServerRunning']();
+}
+
 export function IsRconConnected() {
   return window['go']['main']['App']['IsRconConnected']();
 }
@@ -130,6 +322,22 @@ export function LoadMessageDialog() {
   return window['go']['main']['App']['LoadMessageDialog']();
 }
 
+export function LoadServerDatabaseDialog() {
+  return window['go']['main']['App']['LoadServerDatabaseDialog']();
+}
+
+export function LoadWhitelistImportDialog() {
+  return window['go']['main']['App']['LoadWhitelistImportDialog']();
+}
+
+export function LockVault() {
+  return window['go']['main']['App']['LockVault']();
+}
+
+export function Noclip(arg1, arg2) {
+  return window['go']['main']['App']['Noclip'](arg1, arg2);
+}
+
 export function OpenFileInExplorer(arg1) {
   return window['go']['main']['App']['OpenFileInExplorer'](arg1);
 }
@@ -142,6 +350,10 @@ export function Players() {
   return window['go']['main']['App']['Players']();
 }
 
+export function PreviewPerkLevels(arg1, arg2) {
+  return window['go']['main']['App']['PreviewPerkLevels'](arg1, arg2);
+}
+
 export function RandomLightning() {
   return window['go']['main']['App']['RandomLightning']();
 }
@@ -158,14 +370,34 @@ export function ReloadOptions() {
   return window['go']['main']['App']['ReloadOptions']();
 }
 
+export function RemovePlayerTag(arg1, arg2) {
+  return window['go']['main']['App']['RemovePlayerTag'](arg1, arg2);
+}
+
 export function RemovePlayersFromWhitelist(arg1, arg2) {
   return window['go']['main']['App']['RemovePlayersFromWhitelist'](arg1, arg2);
 }
 
+export function RemoveZombies(arg1, arg2) {
+  return window['go']['main']['App']['RemoveZombies'](arg1, arg2);
+}
+
+export function ResolvePlayerGroup(arg1) {
+  return window['go']['main']['App']['ResolvePlayerGroup'](arg1);
+}
+
 export function RestartApplication(arg1) {
   return window['go']['main']['App']['RestartApplication'](arg1);
 }
 
+export function RestoreBuiltinLocations() {
+  return window['go']['main']['App']['RestoreBuiltinLocations']();
+}
+
+export function ReturnGathered() {
+  return window['go']['main']['App']['ReturnGathered']();
+}
+
 export function SaveConfigDialog() {
   return window['go']['main']['App']['SaveConfigDialog']();
 }
@@ -178,14 +410,42 @@ export function SaveItemsDialog(arg1) {
   return window['go']['main']['App']['SaveItemsDialog'](arg1);
 }
 
+export function SaveKit(arg1, arg2) {
+  return window['go']['main']['App']['SaveKit'](arg1, arg2);
+}
+
+export function SaveLocation(arg1, arg2) {
+  return window['go']['main']['App']['SaveLocation'](arg1, arg2);
+}
+
 export function SaveMessagesDialog(arg1) {
   return window['go']['main']['App']['SaveMessagesDialog'](arg1);
 }
 
+export function SavePlayerGroup(arg1) {
+  return window['go']['main']['App']['SavePlayerGroup'](arg1);
+}
+
+export function SavePlayerTag(arg1) {
+  return window['go']['main']['App']['SavePlayerTag'](arg1);
+}
+
+export function SaveStarterKit(arg1) {
+  return window['go']['main']['App']['SaveStarterKit'](arg1);
+}
+
 export function SaveWorld() {
   return window['go']['main']['App']['SaveWorld']();
 }
 
+export function SearchItems(arg1) {
+  return window['go']['main']['App']['SearchItems'](arg1);
+}
+
+export function SearchVehicles(arg1) {
+  return window['go']['main']['App']['SearchVehicles'](arg1);
+}
+
 export function SendNotification(arg1) {
   return window['go']['main']['App']['SendNotification'](arg1);
 }
@@ -210,6 +470,26 @@ export function SetConfigField(arg1, arg2) {
   return window['go']['main']['App']['SetConfigField'](arg1, arg2);
 }
 
+export function SetPerkLevel(arg1, arg2) {
+  return window['go']['main']['App']['SetPerkLevel'](arg1, arg2);
+}
+
+export function SetPlayerCustomField(arg1, arg2, arg3) {
+  return window['go']['main']['App']['SetPlayerCustomField'](arg1, arg2, arg3);
+}
+
+export function SetPlayerSteamId(arg1, arg2) {
+  return window['go']['main']['App']['SetPlayerSteamId'](arg1, arg2);
+}
+
+export function SetPlayerTags(arg1, arg2) {
+  return window['go']['main']['App']['SetPlayerTags'](arg1, arg2);
+}
+
+export function StartHordeWaves(arg1) {
+  return window['go']['main']['App']['StartHordeWaves'](arg1);
+}
+
 export function StartRain(arg1) {
   return window['go']['main']['App']['StartRain'](arg1);
 }
@@ -218,6 +498,10 @@ export function StartStorm(arg1) {
   return window['go']['main']['App']['StartStorm'](arg1);
 }
 
+export function StopHordeWaves() {
+  return window['go']['main']['App']['StopHordeWaves']();
+}
+
 export function StopRain() {
   return window['go']['main']['App']['StopRain']();
 }
@@ -242,10 +526,18 @@ export function Thunder(arg1) {
   return window['go']['main']['App']['Thunder'](arg1);
 }
 
+export function UnbanSteamIds(arg1) {
+  return window['go']['main']['App']['UnbanSteamIds'](arg1);
+}
+
 export function UnbanUsers(arg1) {
   return window['go']['main']['App']['UnbanUsers'](arg1);
 }
 
+export function UnlockVault(arg1) {
+  return window['go']['main']['App']['UnlockVault'](arg1);
+}
+
 export function Update(arg1) {
   return window['go']['main']['App']['Update'](arg1);
 }
@@ -253,3 +545,11 @@ export function Update(arg1) {
 export function UpdatePzOptions(arg1, arg2) {
   return window['go']['main']['App']['UpdatePzOptions'](arg1, arg2);
 }
+
+export function ValidateGroupRule(arg1) {
+  return window['go']['main']['App']['ValidateGroupRule'](arg1);
+}
+
+export function VoiceBan(arg1, arg2) {
+  return window['go']['main']['App']['VoiceBan'](arg1, arg2);
+}
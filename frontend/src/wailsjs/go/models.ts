@@ -1,5 +1,149 @@
 export namespace main {
 	
+	export class BanEntry {
+	    type: string;
+	    name: string;
+	    steamId: string;
+	    reason: string;
+	    created: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new BanEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.type = source["type"];
+	        this.name = source["name"];
+	        this.steamId = source["steamId"];
+	        this.reason = source["reason"];
+	        this.created = source["created"];
+	    }
+	}
+	export class BannedIp {
+	    ip: string;
+	    username: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BannedIp(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ip = source["ip"];
+	        this.username = source["username"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class BannedSteamId {
+	    steamId: string;
+	    reason: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new BannedSteamId(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.steamId = source["steamId"];
+	        this.reason = source["reason"];
+	    }
+	}
+	export class CatalogEntry {
+	    id: string;
+	    name: string;
+	    category: string;
+	    model?: string;
+	    images: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.name = source["name"];
+	        this.category = source["category"];
+	        this.model = source["model"];
+	        this.images = source["images"];
+	    }
+	}
+	export class CatalogIdCheck {
+	    id: string;
+	    status: string;
+	    module: string;
+	    suggestions: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogIdCheck(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.status = source["status"];
+	        this.module = source["module"];
+	        this.suggestions = source["suggestions"];
+	    }
+	}
+	export class CatalogPage {
+	    entries: CatalogEntry[];
+	    total: number;
+	    page: number;
+	    pageSize: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogPage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.entries = this.convertValues(source["entries"], CatalogEntry);
+	        this.total = source["total"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class CatalogQuery {
+	    query: string;
+	    category: string;
+	    locale: string;
+	    page: number;
+	    pageSize: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new CatalogQuery(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.query = source["query"];
+	        this.category = source["category"];
+	        this.locale = source["locale"];
+	        this.page = source["page"];
+	        this.pageSize = source["pageSize"];
+	    }
+	}
 	export class Config {
 	    theme?: string;
 	    colorScheme?: string;
@@ -26,7 +170,12 @@ export namespace main {
 	    lastUpdateCheck?: number;
 	    rememberCredentials?: boolean;
 	    autoConnect?: boolean;
+	    vaultIdleTimeout?: number;
 	    rconCheckInterval?: number;
+	    latencyWarningThreshold?: number;
+	    enableMetrics?: boolean;
+	    metricsAddress?: string;
+	    analyticsRetentionDays?: number;
 	    disableWeatherControlButtons?: boolean;
 	    disableRandomButtons?: boolean;
 	    disableOtherButtons?: boolean;
@@ -63,17 +212,57 @@ export namespace main {
 	        this.lastUpdateCheck = source["lastUpdateCheck"];
 	        this.rememberCredentials = source["rememberCredentials"];
 	        this.autoConnect = source["autoConnect"];
+	        this.vaultIdleTimeout = source["vaultIdleTimeout"];
 	        this.rconCheckInterval = source["rconCheckInterval"];
+	        this.latencyWarningThreshold = source["latencyWarningThreshold"];
+	        this.enableMetrics = source["enableMetrics"];
+	        this.metricsAddress = source["metricsAddress"];
+	        this.analyticsRetentionDays = source["analyticsRetentionDays"];
 	        this.disableWeatherControlButtons = source["disableWeatherControlButtons"];
 	        this.disableRandomButtons = source["disableRandomButtons"];
 	        this.disableOtherButtons = source["disableOtherButtons"];
 	        this.debugMode = source["debugMode"];
 	    }
 	}
+	export class ConnectionHealth {
+	    server: string;
+	    connected: boolean;
+	    lastLatencyMs: number;
+	    latencyP50Ms: number;
+	    latencyP95Ms: number;
+	    errorRate: number;
+	    requests: number;
+	    errors: number;
+	    reconnects: number;
+	    connectedAt: number;
+	    uptimeSeconds: number;
+	    lagging: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ConnectionHealth(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.server = source["server"];
+	        this.connected = source["connected"];
+	        this.lastLatencyMs = source["lastLatencyMs"];
+	        this.latencyP50Ms = source["latencyP50Ms"];
+	        this.latencyP95Ms = source["latencyP95Ms"];
+	        this.errorRate = source["errorRate"];
+	        this.requests = source["requests"];
+	        this.errors = source["errors"];
+	        this.reconnects = source["reconnects"];
+	        this.connectedAt = source["connectedAt"];
+	        this.uptimeSeconds = source["uptimeSeconds"];
+	        this.lagging = source["lagging"];
+	    }
+	}
 	export class Coordinates {
 	    x: number;
 	    y: number;
 	    z: number;
+	    location?: string;
 	
 	    static createFrom(source: any = {}) {
 	        return new Coordinates(source);
@@ -84,12 +273,34 @@ export namespace main {
 	        this.x = source["x"];
 	        this.y = source["y"];
 	        this.z = source["z"];
+	        this.location = source["location"];
+	    }
+	}
+	export class SSHTunnel {
+	    host: string;
+	    port: string;
+	    user: string;
+	    keyPath: string;
+	    knownHostsPath: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new SSHTunnel(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.host = source["host"];
+	        this.port = source["port"];
+	        this.user = source["user"];
+	        this.keyPath = source["keyPath"];
+	        this.knownHostsPath = source["knownHostsPath"];
 	    }
 	}
 	export class Credentials {
 	    ip: string;
 	    port: string;
 	    password: string;
+	    ssh?: SSHTunnel;
 	
 	    static createFrom(source: any = {}) {
 	        return new Credentials(source);
@@ -100,300 +311,63 @@ export namespace main {
 	        this.ip = source["ip"];
 	        this.port = source["port"];
 	        this.password = source["password"];
+	        this.ssh = this.convertValues(source["ssh"], SSHTunnel);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class PzOptions {
-	    AdminSafehouse: boolean;
-	    AllowCoop: boolean;
-	    AllowDestructionBySledgehammer: boolean;
-	    AllowNonAsciiUsername: boolean;
-	    AnnounceDeath: boolean;
-	    AntiCheatProtectionType1: boolean;
-	    AntiCheatProtectionType2: boolean;
-	    AntiCheatProtectionType3: boolean;
-	    AntiCheatProtectionType4: boolean;
-	    AntiCheatProtectionType5: boolean;
-	    AntiCheatProtectionType6: boolean;
-	    AntiCheatProtectionType7: boolean;
-	    AntiCheatProtectionType8: boolean;
-	    AntiCheatProtectionType9: boolean;
-	    AntiCheatProtectionType10: boolean;
-	    AntiCheatProtectionType11: boolean;
-	    AntiCheatProtectionType12: boolean;
-	    AntiCheatProtectionType13: boolean;
-	    AntiCheatProtectionType14: boolean;
-	    AntiCheatProtectionType15: boolean;
-	    AntiCheatProtectionType16: boolean;
-	    AntiCheatProtectionType17: boolean;
-	    AntiCheatProtectionType18: boolean;
-	    AntiCheatProtectionType19: boolean;
-	    AntiCheatProtectionType20: boolean;
-	    AntiCheatProtectionType21: boolean;
-	    AntiCheatProtectionType22: boolean;
-	    AntiCheatProtectionType23: boolean;
-	    AntiCheatProtectionType24: boolean;
-	    AntiCheatProtectionType2ThresholdMultiplier: number;
-	    AntiCheatProtectionType3ThresholdMultiplier: number;
-	    AntiCheatProtectionType4ThresholdMultiplier: number;
-	    AntiCheatProtectionType9ThresholdMultiplier: number;
-	    AntiCheatProtectionType15ThresholdMultiplier: number;
-	    AntiCheatProtectionType20ThresholdMultiplier: number;
-	    AntiCheatProtectionType22ThresholdMultiplier: number;
-	    AntiCheatProtectionType24ThresholdMultiplier: number;
-	    AutoCreateUserInWhiteList: boolean;
-	    BackupsCount: number;
-	    BackupsOnStart: boolean;
-	    BackupsOnVersionChange: boolean;
-	    BackupsPeriod: number;
-	    BanKickGlobalSound: boolean;
-	    BloodSplatLifespanDays: number;
-	    CarEngineAttractionModifier: number;
-	    ChatStreams: string;
-	    ClientActionLogs: string;
-	    ClientCommandFilter: string;
-	    ConstructionPreventsLootRespawn: boolean;
-	    DefaultPort: number;
-	    DenyLoginOnOverloadedServer: boolean;
-	    DisableRadioAdmin: boolean;
-	    DisableRadioGM: boolean;
-	    DisableRadioInvisible: boolean;
-	    DisableRadioModerator: boolean;
-	    DisableRadioOverseer: boolean;
-	    DisableRadioStaff: boolean;
-	    DisableSafehouseWhenPlayerConnected: boolean;
-	    DiscordEnable: boolean;
-	    DiscordToken: string;
-	    DiscordChannel: string;
-	    DiscordChannelID: string;
-	    DisplayUserName: boolean;
-	    DoLuaChecksum: boolean;
-	    DropOffWhiteListAfterDeath: boolean;
-	    Faction: boolean;
-	    FactionDaySurvivedToCreate: number;
-	    FactionPlayersRequiredForTag: number;
-	    FastForwardMultiplier: number;
-	    GlobalChat: boolean;
-	    HidePlayersBehindYou: boolean;
-	    HoursForLootRespawn: number;
-	    ItemNumbersLimitPerContainer: number;
-	    KickFastPlayers: boolean;
-	    KnockedDownAllowed: boolean;
-	    LoginQueueConnectTimeout: number;
-	    LoginQueueEnabled: boolean;
-	    Map: string;
-	    MapRemotePlayerVisibility: number;
-	    MaxAccountsPerUser: number;
-	    MaxItemsForLootRespawn: number;
-	    MaxPlayers: number;
-	    MinutesPerPage: number;
-	    Mods: string;
-	    MouseOverToSeeDisplayName: boolean;
-	    NoFire: boolean;
-	    Open: boolean;
-	    PVP: boolean;
-	    PVPFirearmDamageModifier: number;
-	    PVPMeleeDamageModifier: number;
-	    PVPMeleeWhileHitReaction: boolean;
-	    PauseEmpty: boolean;
-	    PerkLogs: boolean;
-	    PingLimit: number;
-	    PlayerBumpPlayer: boolean;
-	    PlayerRespawnWithOther: boolean;
-	    PlayerRespawnWithSelf: boolean;
-	    PlayerSafehouse: boolean;
-	    Public: boolean;
-	    PublicDescription: string;
-	    PublicName: string;
-	    RemovePlayerCorpsesOnCorpseRemoval: boolean;
-	    ResetID: number;
-	    SafeHouseRemovalTime: number;
-	    SafehouseAllowFire: boolean;
-	    SafehouseAllowLoot: boolean;
-	    SafehouseAllowNonResidential: boolean;
-	    SafehouseAllowRespawn: boolean;
-	    SafehouseAllowTrepass: boolean;
-	    SafehouseDaySurvivedToClaim: number;
-	    SafetyCooldownTimer: number;
-	    SafetySystem: boolean;
-	    SafetyToggleTimer: number;
-	    SaveWorldEveryMinutes: number;
-	    ServerPlayerID: number;
-	    ShowFirstAndLastName: boolean;
-	    ShowSafety: boolean;
-	    SledgehammerOnlyInSafehouse: boolean;
-	    SleepAllowed: boolean;
-	    SleepNeeded: boolean;
-	    SneakModeHideFromOtherPlayers: boolean;
-	    SpawnItems: string;
-	    SpawnPoint: string;
-	    SpeedLimit: number;
-	    SteamScoreboard: boolean;
-	    SteamVAC: boolean;
-	    TrashDeleteAll: boolean;
-	    UDPPort: number;
-	    UPnP: boolean;
-	    Voice3D: boolean;
-	    VoiceEnable: boolean;
-	    VoiceMaxDistance: number;
-	    VoiceMinDistance: number;
-	    WorkshopItems: string;
-	    ServerWelcomeMessage: string;
+	export class DiagnosticStep {
+	    name: string;
+	    status: string;
+	    message: string;
+	    durationMs: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new PzOptions(source);
+	        return new DiagnosticStep(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.AdminSafehouse = source["AdminSafehouse"];
-	        this.AllowCoop = source["AllowCoop"];
-	        this.AllowDestructionBySledgehammer = source["AllowDestructionBySledgehammer"];
-	        this.AllowNonAsciiUsername = source["AllowNonAsciiUsername"];
-	        this.AnnounceDeath = source["AnnounceDeath"];
-	        this.AntiCheatProtectionType1 = source["AntiCheatProtectionType1"];
-	        this.AntiCheatProtectionType2 = source["AntiCheatProtectionType2"];
-	        this.AntiCheatProtectionType3 = source["AntiCheatProtectionType3"];
-	        this.AntiCheatProtectionType4 = source["AntiCheatProtectionType4"];
-	        this.AntiCheatProtectionType5 = source["AntiCheatProtectionType5"];
-	        this.AntiCheatProtectionType6 = source["AntiCheatProtectionType6"];
-	        this.AntiCheatProtectionType7 = source["AntiCheatProtectionType7"];
-	        this.AntiCheatProtectionType8 = source["AntiCheatProtectionType8"];
-	        this.AntiCheatProtectionType9 = source["AntiCheatProtectionType9"];
-	        this.AntiCheatProtectionType10 = source["AntiCheatProtectionType10"];
-	        this.AntiCheatProtectionType11 = source["AntiCheatProtectionType11"];
-	        this.AntiCheatProtectionType12 = source["AntiCheatProtectionType12"];
-	        this.AntiCheatProtectionType13 = source["AntiCheatProtectionType13"];
-	        this.AntiCheatProtectionType14 = source["AntiCheatProtectionType14"];
-	        this.AntiCheatProtectionType15 = source["AntiCheatProtectionType15"];
-	        this.AntiCheatProtectionType16 = source["AntiCheatProtectionType16"];
-	        this.AntiCheatProtectionType17 = source["AntiCheatProtectionType17"];
-	        this.AntiCheatProtectionType18 = source["AntiCheatProtectionType18"];
-	        this.AntiCheatProtectionType19 = source["AntiCheatProtectionType19"];
-	        this.AntiCheatProtectionType20 = source["AntiCheatProtectionType20"];
-	        this.AntiCheatProtectionType21 = source["AntiCheatProtectionType21"];
-	        this.AntiCheatProtectionType22 = source["AntiCheatProtectionType22"];
-	        this.AntiCheatProtectionType23 = source["AntiCheatProtectionType23"];
-	        this.AntiCheatProtectionType24 = source["AntiCheatProtectionType24"];
-	        this.AntiCheatProtectionType2ThresholdMultiplier = source["AntiCheatProtectionType2ThresholdMultiplier"];
-	        this.AntiCheatProtectionType3ThresholdMultiplier = source["AntiCheatProtectionType3ThresholdMultiplier"];
-	        this.AntiCheatProtectionType4ThresholdMultiplier = source["AntiCheatProtectionType4ThresholdMultiplier"];
-	        this.AntiCheatProtectionType9ThresholdMultiplier = source["AntiCheatProtectionType9ThresholdMultiplier"];
-	        this.AntiCheatProtectionType15ThresholdMultiplier = source["AntiCheatProtectionType15ThresholdMultiplier"];
-	        this.AntiCheatProtectionType20ThresholdMultiplier = source["AntiCheatProtectionType20ThresholdMultiplier"];
-	        this.AntiCheatProtectionType22ThresholdMultiplier = source["AntiCheatProtectionType22ThresholdMultiplier"];
-	        this.AntiCheatProtectionType24ThresholdMultiplier = source["AntiCheatProtectionType24ThresholdMultiplier"];
-	        this.AutoCreateUserInWhiteList = source["AutoCreateUserInWhiteList"];
-	        this.BackupsCount = source["BackupsCount"];
-	        this.BackupsOnStart = source["BackupsOnStart"];
-	        this.BackupsOnVersionChange = source["BackupsOnVersionChange"];
-	        this.BackupsPeriod = source["BackupsPeriod"];
-	        this.BanKickGlobalSound = source["BanKickGlobalSound"];
-	        this.BloodSplatLifespanDays = source["BloodSplatLifespanDays"];
-	        this.CarEngineAttractionModifier = source["CarEngineAttractionModifier"];
-	        this.ChatStreams = source["ChatStreams"];
-	        this.ClientActionLogs = source["ClientActionLogs"];
-	        this.ClientCommandFilter = source["ClientCommandFilter"];
-	        this.ConstructionPreventsLootRespawn = source["ConstructionPreventsLootRespawn"];
-	        this.DefaultPort = source["DefaultPort"];
-	        this.DenyLoginOnOverloadedServer = source["DenyLoginOnOverloadedServer"];
-	        this.DisableRadioAdmin = source["DisableRadioAdmin"];
-	        this.DisableRadioGM = source["DisableRadioGM"];
-	        this.DisableRadioInvisible = source["DisableRadioInvisible"];
-	        this.DisableRadioModerator = source["DisableRadioModerator"];
-	        this.DisableRadioOverseer = source["DisableRadioOverseer"];
-	        this.DisableRadioStaff = source["DisableRadioStaff"];
-	        this.DisableSafehouseWhenPlayerConnected = source["DisableSafehouseWhenPlayerConnected"];
-	        this.DiscordEnable = source["DiscordEnable"];
-	        this.DiscordToken = source["DiscordToken"];
-	        this.DiscordChannel = source["DiscordChannel"];
-	        this.DiscordChannelID = source["DiscordChannelID"];
-	        this.DisplayUserName = source["DisplayUserName"];
-	        this.DoLuaChecksum = source["DoLuaChecksum"];
-	        this.DropOffWhiteListAfterDeath = source["DropOffWhiteListAfterDeath"];
-	        this.Faction = source["Faction"];
-	        this.FactionDaySurvivedToCreate = source["FactionDaySurvivedToCreate"];
-	        this.FactionPlayersRequiredForTag = source["FactionPlayersRequiredForTag"];
-	        this.FastForwardMultiplier = source["FastForwardMultiplier"];
-	        this.GlobalChat = source["GlobalChat"];
-	        this.HidePlayersBehindYou = source["HidePlayersBehindYou"];
-	        this.HoursForLootRespawn = source["HoursForLootRespawn"];
-	        this.ItemNumbersLimitPerContainer = source["ItemNumbersLimitPerContainer"];
-	        this.KickFastPlayers = source["KickFastPlayers"];
-	        this.KnockedDownAllowed = source["KnockedDownAllowed"];
-	        this.LoginQueueConnectTimeout = source["LoginQueueConnectTimeout"];
-	        this.LoginQueueEnabled = source["LoginQueueEnabled"];
-	        this.Map = source["Map"];
-	        this.MapRemotePlayerVisibility = source["MapRemotePlayerVisibility"];
-	        this.MaxAccountsPerUser = source["MaxAccountsPerUser"];
-	        this.MaxItemsForLootRespawn = source["MaxItemsForLootRespawn"];
-	        this.MaxPlayers = source["MaxPlayers"];
-	        this.MinutesPerPage = source["MinutesPerPage"];
-	        this.Mods = source["Mods"];
-	        this.MouseOverToSeeDisplayName = source["MouseOverToSeeDisplayName"];
-	        this.NoFire = source["NoFire"];
-	        this.Open = source["Open"];
-	        this.PVP = source["PVP"];
-	        this.PVPFirearmDamageModifier = source["PVPFirearmDamageModifier"];
-	        this.PVPMeleeDamageModifier = source["PVPMeleeDamageModifier"];
-	        this.PVPMeleeWhileHitReaction = source["PVPMeleeWhileHitReaction"];
-	        this.PauseEmpty = source["PauseEmpty"];
-	        this.PerkLogs = source["PerkLogs"];
-	        this.PingLimit = source["PingLimit"];
-	        this.PlayerBumpPlayer = source["PlayerBumpPlayer"];
-	        this.PlayerRespawnWithOther = source["PlayerRespawnWithOther"];
-	        this.PlayerRespawnWithSelf = source["PlayerRespawnWithSelf"];
-	        this.PlayerSafehouse = source["PlayerSafehouse"];
-	        this.Public = source["Public"];
-	        this.PublicDescription = source["PublicDescription"];
-	        this.PublicName = source["PublicName"];
-	        this.RemovePlayerCorpsesOnCorpseRemoval = source["RemovePlayerCorpsesOnCorpseRemoval"];
-	        this.ResetID = source["ResetID"];
-	        this.SafeHouseRemovalTime = source["SafeHouseRemovalTime"];
-	        this.SafehouseAllowFire = source["SafehouseAllowFire"];
-	        this.SafehouseAllowLoot = source["SafehouseAllowLoot"];
-	        this.SafehouseAllowNonResidential = source["SafehouseAllowNonResidential"];
-	        this.SafehouseAllowRespawn = source["SafehouseAllowRespawn"];
-	        this.SafehouseAllowTrepass = source["SafehouseAllowTrepass"];
-	        this.SafehouseDaySurvivedToClaim = source["SafehouseDaySurvivedToClaim"];
-	        this.SafetyCooldownTimer = source["SafetyCooldownTimer"];
-	        this.SafetySystem = source["SafetySystem"];
-	        this.SafetyToggleTimer = source["SafetyToggleTimer"];
-	        this.SaveWorldEveryMinutes = source["SaveWorldEveryMinutes"];
-	        this.ServerPlayerID = source["ServerPlayerID"];
-	        this.ShowFirstAndLastName = source["ShowFirstAndLastName"];
-	        this.ShowSafety = source["ShowSafety"];
-	        this.SledgehammerOnlyInSafehouse = source["SledgehammerOnlyInSafehouse"];
-	        this.SleepAllowed = source["SleepAllowed"];
-	        this.SleepNeeded = source["SleepNeeded"];
-	        this.SneakModeHideFromOtherPlayers = source["SneakModeHideFromOtherPlayers"];
-	        this.SpawnItems = source["SpawnItems"];
-	        this.SpawnPoint = source["SpawnPoint"];
-	        this.SpeedLimit = source["SpeedLimit"];
-	        this.SteamScoreboard = source["SteamScoreboard"];
-	        this.SteamVAC = source["SteamVAC"];
-	        this.TrashDeleteAll = source["TrashDeleteAll"];
-	        this.UDPPort = source["UDPPort"];
-	        this.UPnP = source["UPnP"];
-	        this.Voice3D = source["Voice3D"];
-	        this.VoiceEnable = source["VoiceEnable"];
-	        this.VoiceMaxDistance = source["VoiceMaxDistance"];
-	        this.VoiceMinDistance = source["VoiceMinDistance"];
-	        this.WorkshopItems = source["WorkshopItems"];
-	        this.ServerWelcomeMessage = source["ServerWelcomeMessage"];
+	        this.name = source["name"];
+	        this.status = source["status"];
+	        this.message = source["message"];
+	        this.durationMs = source["durationMs"];
 	    }
 	}
-	export class ImportOptionsResponse {
-	    options: PzOptions;
+	export class DiagnosticsReport {
+	    address: string;
+	    steps: DiagnosticStep[];
 	    success: boolean;
+	    problem: string;
+	    suggestion: string;
 	
 	    static createFrom(source: any = {}) {
-	        return new ImportOptionsResponse(source);
+	        return new DiagnosticsReport(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.options = this.convertValues(source["options"], PzOptions);
+	        this.address = source["address"];
+	        this.steps = this.convertValues(source["steps"], DiagnosticStep);
 	        this.success = source["success"];
+	        this.problem = source["problem"];
+	        this.suggestion = source["suggestion"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -414,111 +388,913 @@ export namespace main {
 		    return a;
 		}
 	}
-	export class ItemRecord {
-	    itemId: string;
-	    count: number;
+	export class GatherOptions {
+	    target: Coordinates;
+	    columns: number;
+	    spacing: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new ItemRecord(source);
+	        return new GatherOptions(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.itemId = source["itemId"];
-	        this.count = source["count"];
+	        this.target = this.convertValues(source["target"], Coordinates);
+	        this.columns = source["columns"];
+	        this.spacing = source["spacing"];
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class Notification {
-	    title: string;
-	    message: string;
-	    path: string;
-	    variant: string;
-	    parameters?: Record<string, string>;
+	export class GatheredPlayer {
+	    name: string;
+	    previous?: Coordinates;
 	
 	    static createFrom(source: any = {}) {
-	        return new Notification(source);
+	        return new GatheredPlayer(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.title = source["title"];
-	        this.message = source["message"];
-	        this.path = source["path"];
-	        this.variant = source["variant"];
-	        this.parameters = source["parameters"];
+	        this.name = source["name"];
+	        this.previous = this.convertValues(source["previous"], Coordinates);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class Player {
-	    name: string;
-	    online: boolean;
-	    accessLevel: string;
-	    banned: boolean;
-	    godmode: boolean;
+	export class HordeOptions {
+	    count: number;
+	    radius: number;
+	    crawler: boolean;
+	    fallen: boolean;
+	    knockedDown: boolean;
 	
 	    static createFrom(source: any = {}) {
-	        return new Player(source);
+	        return new HordeOptions(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.name = source["name"];
-	        this.online = source["online"];
-	        this.accessLevel = source["accessLevel"];
-	        this.banned = source["banned"];
-	        this.godmode = source["godmode"];
+	        this.count = source["count"];
+	        this.radius = source["radius"];
+	        this.crawler = source["crawler"];
+	        this.fallen = source["fallen"];
+	        this.knockedDown = source["knockedDown"];
 	    }
 	}
-	
-	export class RconResponse {
-	    response: string;
+	export class HordeWaveResult {
+	    wave: number;
+	    success: number;
+	    fail: number;
 	    error: string;
+	    time: number;
 	
 	    static createFrom(source: any = {}) {
-	        return new RconResponse(source);
+	        return new HordeWaveResult(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.response = source["response"];
+	        this.wave = source["wave"];
+	        this.success = source["success"];
+	        this.fail = source["fail"];
 	        this.error = source["error"];
+	        this.time = source["time"];
 	    }
 	}
-	export class ServerMessage {
-	    message: string;
-	    lineColors: Record<number, string>;
+	export class HordeWaves {
+	    points: Coordinates[];
+	    waves: number;
+	    interval: number;
+	    options: HordeOptions;
 	
 	    static createFrom(source: any = {}) {
-	        return new ServerMessage(source);
+	        return new HordeWaves(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.message = source["message"];
-	        this.lineColors = source["lineColors"];
+	        this.points = this.convertValues(source["points"], Coordinates);
+	        this.waves = source["waves"];
+	        this.interval = source["interval"];
+	        this.options = this.convertValues(source["options"], HordeOptions);
 	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
 	}
-	export class UpdateInfo {
-	    updateAvailable: boolean;
-	    currentVersion: string;
-	    latestVersion: string;
-	    name: string;
-	    releaseNotes: string;
-	    downloadUrl: string;
-	    releaseUrl: string;
+	export class HordeWavesStatus {
+	    running: boolean;
+	    waves: number;
+	    results: HordeWaveResult[];
 	
 	    static createFrom(source: any = {}) {
-	        return new UpdateInfo(source);
+	        return new HordeWavesStatus(source);
 	    }
 	
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
-	        this.updateAvailable = source["updateAvailable"];
-	        this.currentVersion = source["currentVersion"];
-	        this.latestVersion = source["latestVersion"];
-	        this.name = source["name"];
-	        this.releaseNotes = source["releaseNotes"];
-	        this.downloadUrl = source["downloadUrl"];
-	        this.releaseUrl = source["releaseUrl"];
+	        this.running = source["running"];
+	        this.waves = source["waves"];
+	        this.results = this.convertValues(source["results"], HordeWaveResult);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PzOptions {
+	
+	
+	    static createFrom(source: any = {}) {
+	        return new PzOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	
+	    }
+	}
+	export class ImportOptionsResponse {
+	    options: PzOptions;
+	    success: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new ImportOptionsResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.options = this.convertValues(source["options"], PzOptions);
+	        this.success = source["success"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ItemRecord {
+	    itemId: string;
+	    count: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new ItemRecord(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.itemId = source["itemId"];
+	        this.count = source["count"];
+	    }
+	}
+	export class KitXp {
+	    perk: string;
+	    amount: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new KitXp(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.perk = source["perk"];
+	        this.amount = source["amount"];
+	    }
+	}
+	export class Kit {
+	    name: string;
+	    items: ItemRecord[];
+	    xp: KitXp[];
+	    vehicle: string;
+	    message: string;
+	    cooldown: number;
+	    limit: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Kit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.items = this.convertValues(source["items"], ItemRecord);
+	        this.xp = this.convertValues(source["xp"], KitXp);
+	        this.vehicle = source["vehicle"];
+	        this.message = source["message"];
+	        this.cooldown = source["cooldown"];
+	        this.limit = source["limit"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class KitGrantResult {
+	    name: string;
+	    success: boolean;
+	    skipped: string;
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new KitGrantResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.success = source["success"];
+	        this.skipped = source["skipped"];
+	        this.errors = source["errors"];
+	    }
+	}
+	
+	export class Location {
+	    name: string;
+	    coordinates: Coordinates;
+	    category: string;
+	    notes: string;
+	    builtin: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new Location(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.coordinates = this.convertValues(source["coordinates"], Coordinates);
+	        this.category = source["category"];
+	        this.notes = source["notes"];
+	        this.builtin = source["builtin"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ModCatalog {
+	    sources: string[];
+	    mods: string[];
+	    modules: string[];
+	    items: CatalogEntry[];
+	    vehicles: CatalogEntry[];
+	    translations: Record<string, any>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ModCatalog(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.sources = source["sources"];
+	        this.mods = source["mods"];
+	        this.modules = source["modules"];
+	        this.items = this.convertValues(source["items"], CatalogEntry);
+	        this.vehicles = this.convertValues(source["vehicles"], CatalogEntry);
+	        this.translations = source["translations"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Notification {
+	    title: string;
+	    message: string;
+	    path: string;
+	    variant: string;
+	    parameters?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Notification(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.title = source["title"];
+	        this.message = source["message"];
+	        this.path = source["path"];
+	        this.variant = source["variant"];
+	        this.parameters = source["parameters"];
+	    }
+	}
+	export class PerkLevelRequest {
+	    perks: string[];
+	    currentLevel: number;
+	    level: number;
+	    multiplier: number;
+	    compensateBoost: boolean;
+	    boost: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PerkLevelRequest(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.perks = source["perks"];
+	        this.currentLevel = source["currentLevel"];
+	        this.level = source["level"];
+	        this.multiplier = source["multiplier"];
+	        this.compensateBoost = source["compensateBoost"];
+	        this.boost = source["boost"];
+	    }
+	}
+	export class PerkXpPreview {
+	    perk: string;
+	    fromLevel: number;
+	    toLevel: number;
+	    xp: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PerkXpPreview(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.perk = source["perk"];
+	        this.fromLevel = source["fromLevel"];
+	        this.toLevel = source["toLevel"];
+	        this.xp = source["xp"];
+	    }
+	}
+	export class PlayerNote {
+	    id: string;
+	    text: string;
+	    author: string;
+	    created: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PlayerNote(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.id = source["id"];
+	        this.text = source["text"];
+	        this.author = source["author"];
+	        this.created = source["created"];
+	    }
+	}
+	export class Player {
+	    name: string;
+	    online: boolean;
+	    accessLevel: string;
+	    banned: boolean;
+	    godmode: boolean;
+	    invisible: boolean;
+	    noclip: boolean;
+	    voiceBanned: boolean;
+	    lastTeleport?: Coordinates;
+	    steamId?: string;
+	    notes?: PlayerNote[];
+	    tags?: string[];
+	    customFields?: Record<string, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new Player(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.online = source["online"];
+	        this.accessLevel = source["accessLevel"];
+	        this.banned = source["banned"];
+	        this.godmode = source["godmode"];
+	        this.invisible = source["invisible"];
+	        this.noclip = source["noclip"];
+	        this.voiceBanned = source["voiceBanned"];
+	        this.lastTeleport = this.convertValues(source["lastTeleport"], Coordinates);
+	        this.steamId = source["steamId"];
+	        this.notes = this.convertValues(source["notes"], PlayerNote);
+	        this.tags = source["tags"];
+	        this.customFields = source["customFields"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class PlayerDifference {
+	    name: string;
+	    kind: string;
+	    current: string;
+	    database: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PlayerDifference(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.kind = source["kind"];
+	        this.current = source["current"];
+	        this.database = source["database"];
+	    }
+	}
+	export class PlayerGroup {
+	    name: string;
+	    type: string;
+	    members: string[];
+	    rule: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PlayerGroup(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.type = source["type"];
+	        this.members = source["members"];
+	        this.rule = source["rule"];
+	    }
+	}
+	
+	export class PlayerTag {
+	    name: string;
+	    color: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new PlayerTag(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.name = source["name"];
+	        this.color = source["color"];
+	    }
+	}
+	export class PopulationBucket {
+	    start: number;
+	    averageOnline: number;
+	    peakOnline: number;
+	    uniquePlayers: number;
+	    newPlayers: number;
+	    sessions: number;
+	    averageSessionSeconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PopulationBucket(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.start = source["start"];
+	        this.averageOnline = source["averageOnline"];
+	        this.peakOnline = source["peakOnline"];
+	        this.uniquePlayers = source["uniquePlayers"];
+	        this.newPlayers = source["newPlayers"];
+	        this.sessions = source["sessions"];
+	        this.averageSessionSeconds = source["averageSessionSeconds"];
+	    }
+	}
+	export class PopulationSummary {
+	    from: number;
+	    to: number;
+	    peakOnline: number;
+	    peakTime: number;
+	    averageOnline: number;
+	    uniquePlayers: number;
+	    newPlayers: number;
+	    returningNewPlayers: number;
+	    retention: number;
+	    sessions: number;
+	    averageSessionSeconds: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new PopulationSummary(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.from = source["from"];
+	        this.to = source["to"];
+	        this.peakOnline = source["peakOnline"];
+	        this.peakTime = source["peakTime"];
+	        this.averageOnline = source["averageOnline"];
+	        this.uniquePlayers = source["uniquePlayers"];
+	        this.newPlayers = source["newPlayers"];
+	        this.returningNewPlayers = source["returningNewPlayers"];
+	        this.retention = source["retention"];
+	        this.sessions = source["sessions"];
+	        this.averageSessionSeconds = source["averageSessionSeconds"];
+	    }
+	}
+	
+	export class RconResponse {
+	    response: string;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new RconResponse(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.response = source["response"];
+	        this.error = source["error"];
+	    }
+	}
+	export class RemoveZombiesOptions {
+	    coordinates?: Coordinates;
+	    radius: number;
+	    reanimated: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new RemoveZombiesOptions(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.coordinates = this.convertValues(source["coordinates"], Coordinates);
+	        this.radius = source["radius"];
+	        this.reanimated = source["reanimated"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ServerDatabaseUser {
+	    username: string;
+	    accessLevel: string;
+	    banned: boolean;
+	    steamId: string;
+	    displayName: string;
+	    lastConnection: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerDatabaseUser(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.username = source["username"];
+	        this.accessLevel = source["accessLevel"];
+	        this.banned = source["banned"];
+	        this.steamId = source["steamId"];
+	        this.displayName = source["displayName"];
+	        this.lastConnection = source["lastConnection"];
+	    }
+	}
+	export class ServerDatabase {
+	    source: string;
+	    users: ServerDatabaseUser[];
+	    bannedSteamIds: BannedSteamId[];
+	    bannedIps: BannedIp[];
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerDatabase(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.source = source["source"];
+	        this.users = this.convertValues(source["users"], ServerDatabaseUser);
+	        this.bannedSteamIds = this.convertValues(source["bannedSteamIds"], BannedSteamId);
+	        this.bannedIps = this.convertValues(source["bannedIps"], BannedIp);
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class ServerDatabaseImport {
+	    database: ServerDatabase;
+	    differences: PlayerDifference[];
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerDatabaseImport(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.database = this.convertValues(source["database"], ServerDatabase);
+	        this.differences = this.convertValues(source["differences"], PlayerDifference);
+	        this.error = source["error"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	
+	export class ServerMessage {
+	    message: string;
+	    lineColors: Record<number, string>;
+	
+	    static createFrom(source: any = {}) {
+	        return new ServerMessage(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.message = source["message"];
+	        this.lineColors = source["lineColors"];
+	    }
+	}
+	export class StarterKit {
+	    enabled: boolean;
+	    items: ItemRecord[];
+	    xp: KitXp[];
+	    message: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new StarterKit(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.enabled = source["enabled"];
+	        this.items = this.convertValues(source["items"], ItemRecord);
+	        this.xp = this.convertValues(source["xp"], KitXp);
+	        this.message = source["message"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class StarterKitGrant {
+	    player: string;
+	    resetId: number;
+	    time: number;
+	    errors: string[];
+	
+	    static createFrom(source: any = {}) {
+	        return new StarterKitGrant(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.player = source["player"];
+	        this.resetId = source["resetId"];
+	        this.time = source["time"];
+	        this.errors = source["errors"];
+	    }
+	}
+	export class UpdateInfo {
+	    updateAvailable: boolean;
+	    currentVersion: string;
+	    latestVersion: string;
+	    name: string;
+	    releaseNotes: string;
+	    downloadUrl: string;
+	    releaseUrl: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new UpdateInfo(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.updateAvailable = source["updateAvailable"];
+	        this.currentVersion = source["currentVersion"];
+	        this.latestVersion = source["latestVersion"];
+	        this.name = source["name"];
+	        this.releaseNotes = source["releaseNotes"];
+	        this.downloadUrl = source["downloadUrl"];
+	        this.releaseUrl = source["releaseUrl"];
+	    }
+	}
+	export class VaultStatus {
+	    exists: boolean;
+	    unlocked: boolean;
+	
+	    static createFrom(source: any = {}) {
+	        return new VaultStatus(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.exists = source["exists"];
+	        this.unlocked = source["unlocked"];
+	    }
+	}
+	export class WhitelistEntry {
+	    username: string;
+	    password: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WhitelistEntry(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.username = source["username"];
+	        this.password = source["password"];
+	    }
+	}
+	export class WhitelistImportResult {
+	    username: string;
+	    password: string;
+	    generated: boolean;
+	    success: boolean;
+	    error: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new WhitelistImportResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.username = source["username"];
+	        this.password = source["password"];
+	        this.generated = source["generated"];
+	        this.success = source["success"];
+	        this.error = source["error"];
 	    }
 	}
 
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type GatherOptions struct {
+	Target  Coordinates `json:"target"`
+	Columns int         `json:"columns"` // Players per row, 0 for a square grid
+	Spacing int         `json:"spacing"` // Tiles between players, at least 1
+}
+
+// GatheredPlayer is a player moved by the last gather. RCON can't read player positions,
+// so the previous position is only known if the player was last teleported to coordinates
+// by pz-admin, and may be outdated if the player walked since
+type GatheredPlayer struct {
+	Name     string       `json:"name"`
+	Previous *Coordinates `json:"previous"`
+}
+
+var lastGather []GatheredPlayer
+
+// gather_grid returns count positions on a grid centered on the target
+func gather_grid(target Coordinates, count int, columns int, spacing int) []Coordinates {
+	if columns <= 0 {
+		columns = int(math.Ceil(math.Sqrt(float64(count))))
+	}
+	columns = max(1, min(columns, count))
+	spacing = max(1, spacing)
+	rows := (count + columns - 1) / columns
+
+	positions := make([]Coordinates, 0, count)
+	for i := 0; i < count; i++ {
+		column, row := i%columns, i/columns
+		positions = append(positions, Coordinates{
+			X: target.X + (column-(columns-1)/2)*spacing,
+			Y: target.Y + (row-(rows-1)/2)*spacing,
+			Z: target.Z,
+		})
+	}
+
+	return positions
+}
+
+// teleport_player must be called with connMutex held
+func teleport_player(name string, coordinates Coordinates) error {
+	res, err := rcon_execute(fmt.Sprintf("teleportto \"%s\" %d,%d,%d", name, coordinates.X, coordinates.Y, coordinates.Z))
+	if err != nil {
+		return err
+	}
+	if res != fmt.Sprintf("%s teleported to %d,%d,%d please wait two seconds to show the map around you.", name, coordinates.X, coordinates.Y, coordinates.Z) {
+		return errors.New(res)
+	}
+
+	if player := find_player(name); player != nil {
+		player.LastTeleport = &coordinates
+	}
+
+	return nil
+}
+
+// Gather teleports the players, or all online players if none are given, to a grid
+// around the target so they don't stack on one tile
+func (app *App) Gather(names []string, options GatherOptions) int {
+	if !resolve_coordinates(&options.Target) {
+		return 0
+	}
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	defer runtime.EventsEmit(app.ctx, "setProgress", 0)
+	runtime.EventsEmit(app.ctx, "setProgress", 10)
+
+	if len(names) == 0 {
+		for _, player := range players {
+			if player.Online {
+				names = append(names, player.Name)
+			}
+		}
+	} else {
+		names = resolve_player_names(names)
+	}
+	if len(names) == 0 {
+		app.SendNotification(Notification{
+			Title:   "rcon.groups.no_players",
+			Variant: "warning",
+		})
+		return 0
+	}
+
+	positions := gather_grid(options.Target, len(names), options.Columns, options.Spacing)
+	gathered := []GatheredPlayer{}
+	successCount := 0
+	var lastErr string
+
+	for i, name := range names {
+		runtime.EventsEmit(app.ctx, "setProgress", int(float64(i+1)/float64(len(names))*100))
+
+		var previous *Coordinates
+		if player := find_player(name); player != nil {
+			previous = player.LastTeleport
+		}
+
+		err := teleport_player(name, positions[i])
+		if err != nil {
+			lastErr = err.Error()
+			runtime.LogWarningf(app.ctx, "Error gathering %s: %s", name, lastErr)
+			continue
+		}
+
+		gathered = append(gathered, GatheredPlayer{Name: name, Previous: previous})
+		successCount++
+	}
+
+	if successCount > 0 {
+		lastGather = gathered
+		players_changed()
+		runtime.EventsEmit(app.ctx, "update-last-gather", lastGather)
+	}
+	runtime.LogInfof(app.ctx, "Gathered %d of %d players at %d,%d,%d", successCount, len(names), options.Target.X, options.Target.Y, options.Target.Z)
+	app.send_bulk_notifications("rcon.gather", successCount, len(names), lastErr)
+
+	return successCount
+}
+
+// gather_reset forgets the last gather, its positions are only valid on the map of the
+// server it was made on, must be called with connMutex held
+func gather_reset() {
+	if lastGather == nil {
+		return
+	}
+
+	lastGather = nil
+	runtime.EventsEmit(app.ctx, "update-last-gather", lastGather)
+}
+
+func (app *App) GetLastGather() []GatheredPlayer {
+	return lastGather
+}
+
+// ReturnGathered teleports the players of the last gather back to their previous position,
+// players without a known previous position stay where they are
+func (app *App) ReturnGathered() int {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	defer runtime.EventsEmit(app.ctx, "setProgress", 0)
+	runtime.EventsEmit(app.ctx, "setProgress", 10)
+
+	returnable := []GatheredPlayer{}
+	unknown := 0
+	for _, gathered := range lastGather {
+		if gathered.Previous == nil {
+			unknown++
+		} else {
+			returnable = append(returnable, gathered)
+		}
+	}
+
+	if unknown > 0 {
+		runtime.LogWarningf(app.ctx, "Previous position of %d gathered players is unknown", unknown)
+		app.SendNotification(Notification{
+			Title:   "rcon.gather.unknown_positions",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", unknown),
+			},
+		})
+	}
+	if len(returnable) == 0 {
+		lastGather = nil
+		runtime.EventsEmit(app.ctx, "update-last-gather", lastGather)
+		return 0
+	}
+
+	successCount := 0
+	var lastErr string
+	for i, gathered := range returnable {
+		runtime.EventsEmit(app.ctx, "setProgress", int(float64(i+1)/float64(len(returnable))*100))
+
+		err := teleport_player(gathered.Name, *gathered.Previous)
+		if err != nil {
+			lastErr = err.Error()
+			runtime.LogWarningf(app.ctx, "Error returning %s: %s", gathered.Name, lastErr)
+			continue
+		}
+		successCount++
+	}
+
+	lastGather = nil
+	players_changed()
+	runtime.EventsEmit(app.ctx, "update-last-gather", lastGather)
+	app.send_bulk_notifications("rcon.returnGathered", successCount, len(returnable), lastErr)
+
+	return successCount
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Access levels from lowest to highest, used to compare levels in group rules
+var accessLevelOrder = []string{"player", "observer", "gm", "overseer", "moderator", "admin"}
+
+// groupRule is a parsed dynamic group rule
+//
+//	rule      = or
+//	or        = and { "OR" and }
+//	and       = not { "AND" not }
+//	not       = "NOT" not | "(" or ")" | condition
+//	condition = field [ operator value ]
+//
+// Fields are online, banned, godmode, invisible, noclip, name, tag, accessLevel and field.<key> for
+// custom fields. Operators are =, !=, and for accessLevel also <, <=, >, >=.
+type groupRule interface {
+	matches(player Player) bool
+}
+
+type groupRuleAnd struct{ left, right groupRule }
+type groupRuleOr struct{ left, right groupRule }
+type groupRuleNot struct{ rule groupRule }
+
+type groupRuleCondition struct {
+	field    string
+	operator string
+	value    string
+}
+
+func (rule groupRuleAnd) matches(player Player) bool {
+	return rule.left.matches(player) && rule.right.matches(player)
+}
+
+func (rule groupRuleOr) matches(player Player) bool {
+	return rule.left.matches(player) || rule.right.matches(player)
+}
+
+func (rule groupRuleNot) matches(player Player) bool {
+	return !rule.rule.matches(player)
+}
+
+func access_level_rank(accessLevel string) int {
+	if accessLevel == "" {
+		return 0
+	}
+
+	return slices.Index(accessLevelOrder, strings.ToLower(accessLevel))
+}
+
+func (rule groupRuleCondition) matches(player Player) bool {
+	switch rule.field {
+	case "online":
+		return player.Online == (rule.value == "true")
+	case "banned":
+		return player.Banned == (rule.value == "true")
+	case "godmode":
+		return player.Godmode == (rule.value == "true")
+	case "invisible":
+		return player.Invisible == (rule.value == "true")
+	case "noclip":
+		return player.Noclip == (rule.value == "true")
+	case "name":
+		return strings.EqualFold(player.Name, rule.value) == (rule.operator == "=")
+	case "tag":
+		return slices.Contains(player.Tags, normalize_tag(rule.value)) == (rule.operator == "=")
+	case "accesslevel":
+		rank, target := access_level_rank(player.AccessLevel), access_level_rank(rule.value)
+		switch rule.operator {
+		case "!=":
+			return rank != target
+		case "<":
+			return rank < target
+		case "<=":
+			return rank <= target
+		case ">":
+			return rank > target
+		case ">=":
+			return rank >= target
+		default:
+			return rank == target
+		}
+	}
+
+	// Custom fields
+	key := strings.TrimPrefix(rule.field, "field.")
+	value, ok := player.CustomFields[key]
+	if !ok {
+		for fieldKey, fieldValue := range player.CustomFields {
+			if strings.EqualFold(fieldKey, key) {
+				value, ok = fieldValue, true
+				break
+			}
+		}
+	}
+	if rule.operator == "" {
+		return ok && value != ""
+	}
+
+	return strings.EqualFold(value, rule.value) == (rule.operator == "=")
+}
+
+// tokenize_group_rule splits a rule into words, operators and parentheses,
+// values may be quoted to contain spaces
+func tokenize_group_rule(rule string) ([]string, error) {
+	tokens := []string{}
+	runes := []rune(rule)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, string(r))
+			i++
+		case r == '=' || r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, string(runes[i:i+2]))
+				i += 2
+			} else if r == '!' {
+				return nil, fmt.Errorf("unexpected '!' at position %d", i+1)
+			} else {
+				tokens = append(tokens, string(r))
+				i++
+			}
+		case r == '"':
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, fmt.Errorf("unterminated quote at position %d", i+1)
+			}
+			tokens = append(tokens, string(runes[i:end+1]))
+			i = end + 1
+		default:
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && !strings.ContainsRune("()=!<>\"", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, string(runes[start:i]))
+		}
+	}
+
+	return tokens, nil
+}
+
+type groupRuleParser struct {
+	tokens []string
+	pos    int
+}
+
+func (parser *groupRuleParser) peek() string {
+	if parser.pos >= len(parser.tokens) {
+		return ""
+	}
+
+	return parser.tokens[parser.pos]
+}
+
+func (parser *groupRuleParser) next() string {
+	token := parser.peek()
+	parser.pos++
+
+	return token
+}
+
+func (parser *groupRuleParser) parseOr() (groupRule, error) {
+	left, err := parser.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(parser.peek(), "or") {
+		parser.next()
+		right, err := parser.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = groupRuleOr{left, right}
+	}
+
+	return left, nil
+}
+
+func (parser *groupRuleParser) parseAnd() (groupRule, error) {
+	left, err := parser.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for strings.EqualFold(parser.peek(), "and") {
+		parser.next()
+		right, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = groupRuleAnd{left, right}
+	}
+
+	return left, nil
+}
+
+func (parser *groupRuleParser) parseNot() (groupRule, error) {
+	token := parser.next()
+
+	switch {
+	case token == "":
+		return nil, fmt.Errorf("unexpected end of rule")
+	case strings.EqualFold(token, "not"):
+		rule, err := parser.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return groupRuleNot{rule}, nil
+	case token == "(":
+		rule, err := parser.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if parser.next() != ")" {
+			return nil, fmt.Errorf("missing closing parenthesis")
+		}
+		return rule, nil
+	case token == ")":
+		return nil, fmt.Errorf("unexpected ')'")
+	}
+
+	return parser.parseCondition(token)
+}
+
+func (parser *groupRuleParser) parseCondition(field string) (groupRule, error) {
+	condition := groupRuleCondition{field: strings.ToLower(field)}
+
+	switch operator := parser.peek(); operator {
+	case "=", "!=", "<", "<=", ">", ">=":
+		parser.next()
+		value := parser.next()
+		if value == "" || value == "(" || value == ")" {
+			return nil, fmt.Errorf("missing value after %s%s", field, operator)
+		}
+		condition.operator = operator
+		condition.value = strings.Trim(value, "\"")
+	}
+
+	switch condition.field {
+	case "online", "banned", "godmode", "invisible", "noclip":
+		switch {
+		case condition.operator == "":
+			condition.value = "true"
+		case condition.operator != "=" && condition.operator != "!=":
+			return nil, fmt.Errorf("%s only supports = and !=", field)
+		case condition.value != "true" && condition.value != "false":
+			return nil, fmt.Errorf("%s must be true or false", field)
+		case condition.operator == "!=":
+			condition.value = map[string]string{"true": "false", "false": "true"}[condition.value]
+		}
+		condition.operator = "="
+	case "name", "tag":
+		if condition.operator != "=" && condition.operator != "!=" {
+			return nil, fmt.Errorf("%s needs = or != and a value", field)
+		}
+	case "accesslevel":
+		if condition.operator == "" {
+			return nil, fmt.Errorf("accessLevel needs an operator and a value")
+		}
+		if access_level_rank(condition.value) == -1 {
+			return nil, fmt.Errorf("unknown access level: %s", condition.value)
+		}
+	default:
+		if !strings.HasPrefix(condition.field, "field.") || len(condition.field) == len("field.") {
+			return nil, fmt.Errorf("unknown field: %s", field)
+		}
+		condition.field = "field." + field[len("field."):] // Keep the case of the key
+		if condition.operator != "" && condition.operator != "=" && condition.operator != "!=" {
+			return nil, fmt.Errorf("custom fields only support = and !=")
+		}
+	}
+
+	return condition, nil
+}
+
+func parse_group_rule(rule string) (groupRule, error) {
+	tokens, err := tokenize_group_rule(rule)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("rule is empty")
+	}
+
+	parser := groupRuleParser{tokens: tokens}
+	parsed, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.pos < len(tokens) {
+		return nil, fmt.Errorf("unexpected %q", parser.peek())
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGroupRuleMatches(t *testing.T) {
+	players := map[string]Player{
+		"online": {Name: "Alice", Online: true, AccessLevel: "moderator", Tags: []string{"vip"}},
+		"banned": {Name: "Bob", Banned: true, Godmode: true, CustomFields: map[string]string{"Discord": "bob#1"}},
+		"admin":  {Name: "Carol Smith", Online: true, AccessLevel: "Admin", Noclip: true},
+	}
+
+	tests := []struct {
+		rule string
+		want map[string]bool
+	}{
+		{"online", map[string]bool{"online": true, "banned": false, "admin": true}},
+		{"online = false", map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"online != true", map[string]bool{"online": false, "banned": true, "admin": false}},
+
+		// AND binds tighter than OR
+		{"noclip OR banned AND godmode", map[string]bool{"online": false, "banned": true, "admin": true}},
+		{"(noclip OR banned) AND godmode", map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"online and tag = vip or banned", map[string]bool{"online": true, "banned": true, "admin": false}},
+
+		{"NOT online", map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"NOT NOT online", map[string]bool{"online": true, "banned": false, "admin": true}},
+		{"NOT online OR noclip", map[string]bool{"online": false, "banned": true, "admin": true}},
+		{"NOT (online OR banned)", map[string]bool{"online": false, "banned": false, "admin": false}},
+
+		{`name = "Carol Smith"`, map[string]bool{"online": false, "banned": false, "admin": true}},
+		{`name != "carol smith"`, map[string]bool{"online": true, "banned": true, "admin": false}},
+		{`tag = " VIP "`, map[string]bool{"online": true, "banned": false, "admin": false}},
+		{`field.discord = "BOB#1"`, map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"field.Discord", map[string]bool{"online": false, "banned": true, "admin": false}},
+
+		{"accessLevel >= moderator", map[string]bool{"online": true, "banned": false, "admin": true}},
+		{"accessLevel > moderator", map[string]bool{"online": false, "banned": false, "admin": true}},
+		{"accessLevel < gm", map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"accessLevel <= player", map[string]bool{"online": false, "banned": true, "admin": false}},
+		{"accessLevel = admin", map[string]bool{"online": false, "banned": false, "admin": true}},
+		{"accessLevel != admin", map[string]bool{"online": true, "banned": true, "admin": false}},
+	}
+
+	for _, test := range tests {
+		rule, err := parse_group_rule(test.rule)
+		if err != nil {
+			t.Errorf("%s: %v", test.rule, err)
+			continue
+		}
+		for key, want := range test.want {
+			if got := rule.matches(players[key]); got != want {
+				t.Errorf("%s: matches(%s) = %v, want %v", test.rule, key, got, want)
+			}
+		}
+	}
+}
+
+func TestValidateGroupRule(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string // Part of the error, empty if the rule is valid
+	}{
+		{"online AND (tag = vip OR accessLevel >= gm)", ""},
+		{`name = "a b" or not banned`, ""},
+		{"", "rule is empty"},
+		{"   ", "rule is empty"},
+		{"online AND", "unexpected end of rule"},
+		{"NOT", "unexpected end of rule"},
+		{"(online", "missing closing parenthesis"},
+		{"online)", `unexpected ")"`},
+		{")", "unexpected ')'"},
+		{"online banned", `unexpected "banned"`},
+		{`name = "unterminated`, "unterminated quote"},
+		{"name ! bob", "unexpected '!'"},
+		{"name =", "missing value after name="},
+		{"name = (", "missing value after name="},
+		{"name", "name needs = or != and a value"},
+		{"online = yes", "online must be true or false"},
+		{"online > true", "online only supports = and !="},
+		{"accessLevel", "accessLevel needs an operator and a value"},
+		{"accessLevel >= owner", "unknown access level: owner"},
+		{"level = 1", "unknown field: level"},
+		{"field. = 1", "unknown field: field."},
+		{"field.discord > a", "custom fields only support = and !="},
+	}
+
+	for _, test := range tests {
+		got := app.ValidateGroupRule(test.rule)
+		switch {
+		case test.want == "" && got != "":
+			t.Errorf("%q: unexpected error %q", test.rule, got)
+		case test.want != "" && !strings.Contains(got, test.want):
+			t.Errorf("%q: error %q, want %q", test.rule, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Number of recent round trips the rolling statistics are computed over
+const healthSampleSize = 200
+
+type ConnectionHealth struct {
+	Server        string  `json:"server"`
+	Connected     bool    `json:"connected"`
+	LastLatencyMs float64 `json:"lastLatencyMs"`
+	LatencyP50Ms  float64 `json:"latencyP50Ms"`
+	LatencyP95Ms  float64 `json:"latencyP95Ms"`
+	ErrorRate     float64 `json:"errorRate"` // 0-1, over the recent round trips
+	Requests      int     `json:"requests"`  // Since the app started
+	Errors        int     `json:"errors"`    // Since the app started
+	Reconnects    int     `json:"reconnects"`
+	ConnectedAt   int64   `json:"connectedAt"` // unix timestamp, 0 when disconnected
+	UptimeSeconds int64   `json:"uptimeSeconds"`
+	Lagging       bool    `json:"lagging"` // p95 latency is above the configured threshold
+}
+
+type healthSample struct {
+	latency time.Duration
+	failed  bool
+}
+
+type serverHealth struct {
+	samples     []healthSample
+	next        int
+	requests    int
+	errors      int
+	reconnects  int
+	connectedAt time.Time
+	lagging     bool
+}
+
+var (
+	healthMutex sync.Mutex
+	healthStats = make(map[string]*serverHealth)
+)
+
+// rcon_execute runs a command on the connection and records its round trip,
+// must be called with connMutex held
+func rcon_execute(command string) (string, error) {
+	// The connection is nil while the SSH tunnel reconnects
+	if conn == nil {
+		return "", errors.New(errRconNotConnected)
+	}
+
+	started := time.Now()
+	res, err := conn.Execute(command)
+	latency := time.Since(started)
+	health_record(latency, err != nil)
+	metrics_record_request(command, latency, err != nil)
+
+	return res, err
+}
+
+func get_server_health(server string) *serverHealth {
+	stats, ok := healthStats[server]
+	if !ok {
+		stats = &serverHealth{samples: make([]healthSample, 0, healthSampleSize)}
+		healthStats[server] = stats
+	}
+
+	return stats
+}
+
+func health_record(latency time.Duration, failed bool) {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	stats := get_server_health(get_server_id())
+	sample := healthSample{latency: latency, failed: failed}
+
+	if len(stats.samples) < healthSampleSize {
+		stats.samples = append(stats.samples, sample)
+	} else {
+		stats.samples[stats.next] = sample
+	}
+	stats.next = (stats.next + 1) % healthSampleSize
+
+	stats.requests++
+	if failed {
+		stats.errors++
+	}
+}
+
+// health_connected starts the uptime of the connected server, connecting to a
+// server again in the same session counts as a reconnect
+func health_connected() {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	server := get_server_id()
+	_, seen := healthStats[server]
+	stats := get_server_health(server)
+	if seen {
+		stats.reconnects++
+		metrics_record_reconnect()
+	}
+	stats.connectedAt = time.Now()
+	metrics_set_connected(true)
+}
+
+func health_reconnected() {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	get_server_health(get_server_id()).reconnects++
+	metrics_record_reconnect()
+}
+
+func health_disconnected() {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	get_server_health(get_server_id()).connectedAt = time.Time{}
+	metrics_set_connected(false)
+}
+
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	index := int(float64(len(sorted)-1) * p)
+	return sorted[index]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+func health_snapshot(server string) ConnectionHealth {
+	stats := get_server_health(server)
+
+	health := ConnectionHealth{
+		Server:     server,
+		Connected:  !stats.connectedAt.IsZero(),
+		Requests:   stats.requests,
+		Errors:     stats.errors,
+		Reconnects: stats.reconnects,
+	}
+
+	if health.Connected {
+		health.ConnectedAt = stats.connectedAt.Unix()
+		health.UptimeSeconds = int64(time.Since(stats.connectedAt).Seconds())
+	}
+
+	if len(stats.samples) == 0 {
+		return health
+	}
+
+	latencies := make([]time.Duration, 0, len(stats.samples))
+	failed := 0
+	for _, sample := range stats.samples {
+		if sample.failed {
+			failed++
+			continue
+		}
+		latencies = append(latencies, sample.latency)
+	}
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+	last := stats.samples[(stats.next-1+len(stats.samples))%len(stats.samples)]
+	health.LastLatencyMs = milliseconds(last.latency)
+	health.LatencyP50Ms = milliseconds(percentile(latencies, 0.5))
+	health.LatencyP95Ms = milliseconds(percentile(latencies, 0.95))
+	health.ErrorRate = float64(failed) / float64(len(stats.samples))
+	health.Lagging = health.LatencyP95Ms > float64(*config.LatencyWarningThreshold)
+
+	return health
+}
+
+func (app *App) GetConnectionHealth() ConnectionHealth {
+	healthMutex.Lock()
+	defer healthMutex.Unlock()
+
+	return health_snapshot(get_server_id())
+}
+
+// health_emit sends the current health to the frontend and warns once when the
+// server starts lagging
+func health_emit() {
+	healthMutex.Lock()
+	server := get_server_id()
+	health := health_snapshot(server)
+	stats := get_server_health(server)
+	startedLagging := health.Lagging && !stats.lagging
+	stats.lagging = health.Lagging
+	healthMutex.Unlock()
+
+	runtime.EventsEmit(app.ctx, "connection-health", health)
+
+	if startedLagging {
+		runtime.LogWarningf(app.ctx, "Server is lagging, p95 latency: %.1f ms", health.LatencyP95Ms)
+		app.SendNotification(Notification{
+			Title:   "rcon.server_lagging",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"ms": fmt.Sprintf("%.0f", health.LatencyP95Ms),
+			},
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type HordeOptions struct {
+	Count       int  `json:"count"`
+	Radius      int  `json:"radius"`
+	Crawler     bool `json:"crawler"`
+	Fallen      bool `json:"fallen"` // Zombies spawn lying on the ground
+	KnockedDown bool `json:"knockedDown"`
+}
+
+// HordeWaves spawns a horde at each point every interval until all waves are spawned
+type HordeWaves struct {
+	Points   []Coordinates `json:"points"`
+	Waves    int           `json:"waves"`
+	Interval int           `json:"interval"` // Seconds between waves
+	Options  HordeOptions  `json:"options"`
+}
+
+type HordeWaveResult struct {
+	Wave    int    `json:"wave"`
+	Success int    `json:"success"` // Points the horde was spawned at
+	Fail    int    `json:"fail"`
+	Error   string `json:"error"`
+	Time    int64  `json:"time"` // unix timestamp
+}
+
+type HordeWavesStatus struct {
+	Running bool              `json:"running"`
+	Waves   int               `json:"waves"`
+	Results []HordeWaveResult `json:"results"`
+}
+
+const errRconNotConnected = "RCON is not connected"
+
+var (
+	hordeWavesMutex  sync.Mutex
+	hordeWavesStatus = HordeWavesStatus{Results: []HordeWaveResult{}}
+	stopHordeWaves   chan struct{}
+)
+
+func horde_command(coordinates Coordinates, options HordeOptions) string {
+	command := fmt.Sprintf("createhorde2 -x %d -y %d -z %d -count %d", coordinates.X, coordinates.Y, coordinates.Z, options.Count)
+	if options.Radius > 0 {
+		command += fmt.Sprintf(" -radius %d", options.Radius)
+	}
+	if options.Crawler {
+		command += " -crawler true"
+	}
+	if options.Fallen {
+		command += " -isFallOnFront true"
+	}
+	if options.KnockedDown {
+		command += " -knockedDown true"
+	}
+
+	return command
+}
+
+func validate_horde_options(options HordeOptions) error {
+	if options.Count <= 0 {
+		return errors.New("horde size must be greater than 0")
+	}
+	if options.Radius < 0 {
+		return errors.New("radius can't be negative")
+	}
+
+	return nil
+}
+
+// CreateHordeAt spawns a horde at the coordinates with createhorde2
+func (app *App) CreateHordeAt(coordinates Coordinates, options HordeOptions) {
+	if !resolve_coordinates(&coordinates) {
+		return
+	}
+	if err := validate_horde_options(options); err != nil {
+		runtime.LogError(app.ctx, err.Error())
+		return
+	}
+
+	command := RCONCommand{
+		CommandTemplate: horde_command(coordinates, options),
+		SuccessCheck: func(name string, response string) bool {
+			return response == "Horde spawned."
+		},
+		Notifications: RCONCommandNotifications{
+			SingleSuccess: "rcon.createHordeAt.success",
+			SingleFail:    "rcon.createHordeAt.fail",
+		},
+	}
+
+	command.execute()
+}
+
+// spawn_horde_wave spawns a horde at each point
+func spawn_horde_wave(points []Coordinates, options HordeOptions) (int, string) {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	success := 0
+	lastErr := ""
+	for _, point := range points {
+		if conn == nil {
+			return success, errRconNotConnected
+		}
+
+		command := horde_command(point, options)
+		res, err := rcon_execute(command)
+		if err != nil {
+			lastErr = err.Error()
+			continue
+		}
+		if res != "Horde spawned." {
+			lastErr = res
+			continue
+		}
+
+		metrics_record_success(command)
+		success++
+	}
+
+	return success, lastErr
+}
+
+func (app *App) run_horde_waves(waves HordeWaves, stop chan struct{}) {
+	defer func() {
+		hordeWavesMutex.Lock()
+		hordeWavesStatus.Running = false
+		runtime.EventsEmit(app.ctx, "horde-waves", hordeWavesStatus)
+		hordeWavesMutex.Unlock()
+	}()
+
+	for wave := 1; wave <= waves.Waves; wave++ {
+		success, lastErr := spawn_horde_wave(waves.Points, waves.Options)
+		result := HordeWaveResult{
+			Wave:    wave,
+			Success: success,
+			Fail:    len(waves.Points) - success,
+			Error:   lastErr,
+			Time:    time.Now().Unix(),
+		}
+		runtime.LogInfof(app.ctx, "Horde wave %d/%d spawned at %d of %d points", wave, waves.Waves, success, len(waves.Points))
+
+		hordeWavesMutex.Lock()
+		hordeWavesStatus.Results = append(hordeWavesStatus.Results, result)
+		runtime.EventsEmit(app.ctx, "horde-waves", hordeWavesStatus)
+		hordeWavesMutex.Unlock()
+
+		if result.Fail > 0 {
+			app.SendNotification(Notification{
+				Title:   "rcon.hordeWaves.wave_failed",
+				Message: lastErr,
+				Variant: "warning",
+				Parameters: map[string]string{
+					"wave": fmt.Sprintf("%d", wave),
+					"f":    fmt.Sprintf("%d", result.Fail),
+				},
+			})
+		}
+
+		if lastErr == errRconNotConnected {
+			runtime.LogWarningf(app.ctx, "Horde waves stopped after wave %d, RCON is not connected", wave)
+			return
+		}
+		if wave == waves.Waves {
+			break
+		}
+
+		select {
+		case <-stop:
+			runtime.LogInfof(app.ctx, "Horde waves stopped after wave %d", wave)
+			app.SendNotification(Notification{
+				Title:   "rcon.hordeWaves.stopped",
+				Variant: "info",
+				Parameters: map[string]string{
+					"wave": fmt.Sprintf("%d", wave),
+				},
+			})
+			return
+		case <-time.After(time.Duration(waves.Interval) * time.Second):
+		}
+	}
+
+	app.SendNotification(Notification{
+		Title:   "rcon.hordeWaves.finished",
+		Variant: "success",
+		Parameters: map[string]string{
+			"n": fmt.Sprintf("%d", waves.Waves),
+		},
+	})
+}
+
+// StartHordeWaves starts spawning the waves in the background, the results of
+// each wave are sent with the "horde-waves" event
+func (app *App) StartHordeWaves(waves HordeWaves) string {
+	err := validate_horde_options(waves.Options)
+	if err == nil {
+		switch {
+		case len(waves.Points) == 0:
+			err = errors.New("no spawn points given")
+		case waves.Waves <= 0:
+			err = errors.New("wave count must be greater than 0")
+		case waves.Interval < 1:
+			err = errors.New("interval must be at least 1 second")
+		}
+	}
+	if err != nil {
+		runtime.LogWarning(app.ctx, "Invalid horde waves: "+err.Error())
+		return err.Error()
+	}
+
+	waves.Points = slices.Clone(waves.Points)
+	for i := range waves.Points {
+		if !resolve_coordinates(&waves.Points[i]) {
+			return fmt.Sprintf("unknown location: %s", waves.Points[i].Location)
+		}
+	}
+
+	hordeWavesMutex.Lock()
+	defer hordeWavesMutex.Unlock()
+
+	if hordeWavesStatus.Running {
+		return "horde waves are already running"
+	}
+
+	hordeWavesStatus = HordeWavesStatus{
+		Running: true,
+		Waves:   waves.Waves,
+		Results: []HordeWaveResult{},
+	}
+	stopHordeWaves = make(chan struct{})
+	go app.run_horde_waves(waves, stopHordeWaves)
+
+	runtime.LogInfof(app.ctx, "Starting %d horde waves at %d points", waves.Waves, len(waves.Points))
+	runtime.EventsEmit(app.ctx, "horde-waves", hordeWavesStatus)
+
+	return ""
+}
+
+// StopHordeWaves stops the running waves before the next wave
+func (app *App) StopHordeWaves() {
+	hordeWavesMutex.Lock()
+	defer hordeWavesMutex.Unlock()
+
+	if hordeWavesStatus.Running && stopHordeWaves != nil {
+		close(stopHordeWaves)
+		stopHordeWaves = nil
+	}
+}
+
+func (app *App) GetHordeWavesStatus() HordeWavesStatus {
+	hordeWavesMutex.Lock()
+	defer hordeWavesMutex.Unlock()
+
+	return hordeWavesStatus
+}
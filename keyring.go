@@ -0,0 +1,166 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"github.com/zalando/go-keyring"
+)
+
+const keyringService = "pz-admin"
+
+// Passphrase used by older versions to encrypt the password in credentials.json
+const legacyCredentialsKey = "6f6c11c2-1dc8-417d-a68e-0e487629"
+
+const (
+	CredentialStorageKeyring = "keyring" // Password is kept in the platform secret store
+	CredentialStorageFile    = "file"    // Password is encrypted with the local key file
+)
+
+// StoredCredentials is the on-disk form of Credentials
+type StoredCredentials struct {
+	IP         string     `json:"ip"`
+	Port       string     `json:"port"`
+	Storage    string     `json:"storage,omitempty"`    // keyring, file, empty for the legacy format
+	KeyringRef string     `json:"keyringRef,omitempty"` // Keyring account holding the password
+	Password   string     `json:"password,omitempty"`   // Encrypted, only set for file storage
+	SSH        *SSHTunnel `json:"ssh,omitempty"`
+}
+
+func keyring_account(credentials Credentials) string {
+	account := "rcon:" + credentials.IP + "-" + credentials.Port
+	if credentials.SSH != nil {
+		account += "@" + credentials.SSH.Host
+	}
+
+	return account
+}
+
+// store_credentials moves the password into the platform keyring and falls back
+// to the local key file when no keyring is available
+func store_credentials(credentials Credentials) (StoredCredentials, error) {
+	stored := StoredCredentials{
+		IP:   credentials.IP,
+		Port: credentials.Port,
+		SSH:  credentials.SSH,
+	}
+
+	account := keyring_account(credentials)
+	err := keyring.Set(keyringService, account, credentials.Password)
+	if err == nil {
+		stored.Storage = CredentialStorageKeyring
+		stored.KeyringRef = account
+		return stored, nil
+	}
+	runtime.LogWarningf(appContext, "Keyring unavailable, falling back to encrypted file: %s", err.Error())
+
+	key, err := get_local_credentials_key()
+	if err != nil {
+		return StoredCredentials{}, err
+	}
+
+	stored.Password, err = Encrypt(credentials.Password, key)
+	if err != nil {
+		return StoredCredentials{}, err
+	}
+	stored.Storage = CredentialStorageFile
+
+	return stored, nil
+}
+
+// load_credentials resolves the password of stored credentials
+func load_credentials(stored StoredCredentials) (Credentials, error) {
+	credentials := Credentials{
+		IP:   stored.IP,
+		Port: stored.Port,
+		SSH:  stored.SSH,
+	}
+
+	var err error
+	switch stored.Storage {
+	case CredentialStorageKeyring:
+		credentials.Password, err = keyring.Get(keyringService, stored.KeyringRef)
+	case CredentialStorageFile:
+		var key string
+		key, err = get_local_credentials_key()
+		if err == nil {
+			credentials.Password, err = Decrypt(stored.Password, key)
+		}
+	default:
+		err = errors.New("unknown credential storage: " + stored.Storage)
+	}
+
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return credentials, nil
+}
+
+// credentials_legacy tells if the credentials were saved by versions without the keyring
+// or without authenticated encryption
+func credentials_legacy(stored StoredCredentials) bool {
+	return stored.Storage == "" || (stored.Storage == CredentialStorageFile && isLegacyCiphertext(stored.Password))
+}
+
+// load_legacy_credentials decrypts legacy credentials, only used to migrate them once
+func load_legacy_credentials(stored StoredCredentials) (Credentials, error) {
+	key := legacyCredentialsKey
+	if stored.Storage == CredentialStorageFile {
+		var err error
+		key, err = get_local_credentials_key()
+		if err != nil {
+			return Credentials{}, err
+		}
+	}
+
+	password, err := decryptCFB(stored.Password, key)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	return Credentials{IP: stored.IP, Port: stored.Port, Password: password, SSH: stored.SSH}, nil
+}
+
+// forget_credentials removes the password of stored credentials from the keyring
+func forget_credentials(stored StoredCredentials) error {
+	if stored.Storage != CredentialStorageKeyring {
+		return nil
+	}
+
+	err := keyring.Delete(keyringService, stored.KeyringRef)
+	if err != nil && !errors.Is(err, keyring.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// get_local_credentials_key returns the per-installation key used when no keyring
+// is available, creating it on first use
+func get_local_credentials_key() (string, error) {
+	if file_exists(credentialsKeyPath) {
+		key, err := os.ReadFile(credentialsKeyPath)
+		if err != nil {
+			return "", err
+		}
+		return string(key), nil
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	key := hex.EncodeToString(buf)
+
+	err := os.WriteFile(credentialsKeyPath, []byte(key), 0o600)
+	if err != nil {
+		return "", err
+	}
+	runtime.LogInfo(appContext, "Created local credentials key: "+credentialsKeyPath)
+
+	return key, nil
+}
@@ -0,0 +1,362 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type KitXp struct {
+	Perk   string `json:"perk"`
+	Amount int    `json:"amount"`
+}
+
+// Kit is a named bundle granted to players in one go
+type Kit struct {
+	Name     string       `json:"name"`
+	Items    []ItemRecord `json:"items"`
+	Xp       []KitXp      `json:"xp"`
+	Vehicle  string       `json:"vehicle"`  // Vehicle spawned at the player, empty for none
+	Message  string       `json:"message"`  // Server message sent after granting, {name} is replaced with the player
+	Cooldown int          `json:"cooldown"` // Minutes before the same player can get the kit again, 0 for none
+	Limit    int          `json:"limit"`    // Times a player can get the kit, 0 for unlimited
+}
+
+// KitGrant is a kit granted to a player, kept per server for cooldowns and limits
+type KitGrant struct {
+	Kit    string `json:"kit"`
+	Player string `json:"player"`
+	Time   int64  `json:"time"` // unix timestamp
+}
+
+type KitGrantResult struct {
+	Name    string   `json:"name"`
+	Success bool     `json:"success"`
+	Skipped string   `json:"skipped"` // cooldown, limit
+	Errors  []string `json:"errors"`
+}
+
+const (
+	KitSkippedCooldown = "cooldown"
+	KitSkippedLimit    = "limit"
+)
+
+var kits []Kit
+var kitGrants []KitGrant
+
+func kits_init() error {
+	kits = []Kit{}
+	if !file_exists(kitsPath) {
+		return nil
+	}
+
+	err := readJSON(kitsPath, &kits)
+	if err != nil {
+		kits = []Kit{}
+		return errors.New("Error reading kits file: " + err.Error())
+	}
+
+	return nil
+}
+
+func kits_save() error {
+	return writeJSON(kitsPath, kits)
+}
+
+func kit_grants_init() error {
+	kitGrants = []KitGrant{}
+
+	grantsFilePath := filepath.Join(get_server_folder(), "kit_grants.json")
+	if !file_exists(grantsFilePath) {
+		return nil
+	}
+
+	err := readJSON(grantsFilePath, &kitGrants)
+	if err != nil {
+		kitGrants = []KitGrant{}
+		return errors.New("Error reading kit grants file: " + err.Error())
+	}
+
+	return nil
+}
+
+func kit_grants_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "kit_grants.json"), kitGrants)
+}
+
+func find_kit(name string) *Kit {
+	for i := range kits {
+		if strings.EqualFold(kits[i].Name, strings.TrimSpace(name)) {
+			return &kits[i]
+		}
+	}
+
+	return nil
+}
+
+func validate_kit(kit Kit) error {
+	if kit.Name == "" {
+		return errors.New("kit name is empty")
+	}
+	if len(kit.Items) == 0 && len(kit.Xp) == 0 && kit.Vehicle == "" && kit.Message == "" {
+		return errors.New("kit is empty")
+	}
+	if kit.Cooldown < 0 || kit.Limit < 0 {
+		return errors.New("cooldown and limit can't be negative")
+	}
+
+	for _, item := range kit.Items {
+		if item.ItemId == "" || item.Count <= 0 {
+			return fmt.Errorf("invalid item: %s", item.ItemId)
+		}
+	}
+	for _, xp := range kit.Xp {
+		if xp.Perk == "" || xp.Amount <= 0 {
+			return fmt.Errorf("invalid xp: %s", xp.Perk)
+		}
+	}
+	if strings.Contains(kit.Vehicle, "\"") || strings.Contains(kit.Message, "\"") {
+		return errors.New("vehicle and message can't contain quotes")
+	}
+
+	return nil
+}
+
+// kit_skip_reason checks the cooldown and limit of the kit for the player
+func kit_skip_reason(kit Kit, name string, now time.Time) string {
+	count := 0
+	var last int64
+	for _, grant := range kitGrants {
+		if strings.EqualFold(grant.Kit, kit.Name) && grant.Player == name {
+			count++
+			last = max(last, grant.Time)
+		}
+	}
+
+	if kit.Limit > 0 && count >= kit.Limit {
+		return KitSkippedLimit
+	}
+	if kit.Cooldown > 0 && count > 0 && now.Before(time.Unix(last, 0).Add(time.Duration(kit.Cooldown)*time.Minute)) {
+		return KitSkippedCooldown
+	}
+
+	return ""
+}
+
+// kit_execute runs a command and compares the response, must be called with connMutex held
+func kit_execute(command string, expected string) error {
+	res, err := rcon_execute(command)
+	if err != nil {
+		return err
+	}
+	if res != expected {
+		return fmt.Errorf("%s: %s", command, res)
+	}
+
+	metrics_record_success(command)
+	return nil
+}
+
+// grant_kit gives every part of the kit to the player and returns the errors and the
+// kit of the failed parts, must be called with connMutex held
+func grant_kit(kit Kit, name string) (bool, []string, Kit) {
+	errs := []string{}
+	granted := false
+	failed := Kit{Name: kit.Name, Items: []ItemRecord{}, Xp: []KitXp{}}
+
+	record := func(err error) bool {
+		if err != nil {
+			errs = append(errs, err.Error())
+			return false
+		}
+		granted = true
+		return true
+	}
+
+	for _, item := range kit.Items {
+		if !record(kit_execute(
+			fmt.Sprintf("additem \"%s\" \"%s\" %d", name, item.ItemId, item.Count),
+			fmt.Sprintf("Item %s Added in %s's inventory.", item.ItemId, name),
+		)) {
+			failed.Items = append(failed.Items, item)
+		}
+	}
+
+	for _, xp := range kit.Xp {
+		if !record(kit_execute(
+			fmt.Sprintf("addxp \"%s\" %s=%d", name, xp.Perk, xp.Amount),
+			fmt.Sprintf("Added %d %s xp's to %s", xp.Amount, xp.Perk, name),
+		)) {
+			failed.Xp = append(failed.Xp, xp)
+		}
+	}
+
+	if kit.Vehicle != "" && !record(kit_execute(fmt.Sprintf("addvehicle \"%s\" \"%s\"", kit.Vehicle, name), "Vehicle spawned")) {
+		failed.Vehicle = kit.Vehicle
+	}
+
+	// The message is only sent if the player got something
+	failed.Message = kit.Message
+	if kit.Message != "" && (granted || len(errs) == 0) {
+		message := strings.ReplaceAll(kit.Message, "{name}", name)
+		if record(kit_execute(fmt.Sprintf("servermsg \"%s\"", message), "Message sent.")) {
+			failed.Message = ""
+		}
+	}
+
+	if granted {
+		kitGrants = append(kitGrants, KitGrant{Kit: kit.Name, Player: name, Time: time.Now().Unix()})
+	}
+
+	return granted, errs, failed
+}
+
+func (app *App) GetKits() []Kit {
+	return kits
+}
+
+// SaveKit adds or replaces a kit, previousName renames an existing one,
+// returns an empty string on success or the error
+func (app *App) SaveKit(kit Kit, previousName string) string {
+	kit.Name = strings.TrimSpace(kit.Name)
+	kit.Vehicle = strings.TrimSpace(kit.Vehicle)
+	if kit.Items == nil {
+		kit.Items = []ItemRecord{}
+	}
+	if kit.Xp == nil {
+		kit.Xp = []KitXp{}
+	}
+	if err := validate_kit(kit); err != nil {
+		return err.Error()
+	}
+
+	if previousName == "" {
+		previousName = kit.Name
+	}
+	if existing := find_kit(kit.Name); existing != nil && !strings.EqualFold(kit.Name, previousName) {
+		return fmt.Sprintf("a kit named %s already exists", kit.Name)
+	}
+
+	if existing := find_kit(previousName); existing != nil {
+		*existing = kit
+	} else {
+		kits = append(kits, kit)
+	}
+
+	err := kits_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving kits: "+err.Error())
+		return err.Error()
+	}
+	runtime.EventsEmit(app.ctx, "update-kits", kits)
+
+	return ""
+}
+
+func (app *App) DeleteKit(name string) bool {
+	index := slices.IndexFunc(kits, func(kit Kit) bool { return strings.EqualFold(kit.Name, name) })
+	if index == -1 {
+		return false
+	}
+	kits = slices.Delete(kits, index, index+1)
+
+	err := kits_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving kits: "+err.Error())
+		return false
+	}
+	runtime.EventsEmit(app.ctx, "update-kits", kits)
+
+	return true
+}
+
+// GrantKit gives the kit to each player, skipping players on cooldown or over the
+// limit, and returns the result of each player
+func (app *App) GrantKit(kitName string, names []string) []KitGrantResult {
+	kit := find_kit(kitName)
+	if kit == nil {
+		runtime.LogWarningf(app.ctx, "Unknown kit: %s", kitName)
+		app.SendNotification(Notification{
+			Title:   "rcon.grantKit.unknown_kit",
+			Variant: "error",
+			Parameters: map[string]string{
+				"name": kitName,
+			},
+		})
+		return nil
+	}
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	defer runtime.EventsEmit(app.ctx, "setProgress", 0)
+	runtime.EventsEmit(app.ctx, "setProgress", 10)
+
+	names = resolve_player_names(names)
+	if len(names) == 0 {
+		app.SendNotification(Notification{
+			Title:   "rcon.groups.no_players",
+			Variant: "warning",
+		})
+		return nil
+	}
+
+	results := make([]KitGrantResult, 0, len(names))
+	successCount, skipped := 0, 0
+	var lastErr string
+	now := time.Now()
+
+	for i, name := range names {
+		runtime.EventsEmit(app.ctx, "setProgress", int(float64(i+1)/float64(len(names))*100))
+
+		result := KitGrantResult{Name: name, Errors: []string{}}
+		if result.Skipped = kit_skip_reason(*kit, name, now); result.Skipped != "" {
+			runtime.LogInfof(app.ctx, "Not granting kit %s to %s: %s", kit.Name, name, result.Skipped)
+			skipped++
+			results = append(results, result)
+			continue
+		}
+
+		granted, errs, _ := grant_kit(*kit, name)
+		result.Errors = errs
+		result.Success = granted && len(errs) == 0
+		if result.Success {
+			successCount++
+		} else if len(errs) > 0 {
+			lastErr = errs[len(errs)-1]
+			runtime.LogWarningf(app.ctx, "Error granting kit %s to %s: %s", kit.Name, name, strings.Join(errs, "; "))
+		}
+		results = append(results, result)
+	}
+
+	err := kit_grants_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving kit grants: "+err.Error())
+	}
+
+	runtime.LogInfof(app.ctx, "Granted kit %s to %d of %d players, %d skipped", kit.Name, successCount, len(names), skipped)
+	if skipped > 0 {
+		app.SendNotification(Notification{
+			Title:   "rcon.grantKit.skipped",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", skipped),
+			},
+		})
+	}
+	if skipped < len(names) {
+		app.send_bulk_notifications("rcon.grantKit", successCount, len(names)-skipped, lastErr)
+	}
+
+	return results
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type Location struct {
+	Name        string      `json:"name"`
+	Coordinates Coordinates `json:"coordinates"`
+	Category    string      `json:"category"`
+	Notes       string      `json:"notes"`
+	Builtin     bool        `json:"builtin"`
+}
+
+// Town centers of the vanilla map
+var builtinLocations = []Location{
+	{Name: "Muldraugh", Coordinates: Coordinates{X: 10735, Y: 9647}, Category: "Town", Builtin: true},
+	{Name: "West Point", Coordinates: Coordinates{X: 11925, Y: 6880}, Category: "Town", Builtin: true},
+	{Name: "Riverside", Coordinates: Coordinates{X: 6370, Y: 5310}, Category: "Town", Builtin: true},
+	{Name: "Rosewood", Coordinates: Coordinates{X: 8110, Y: 11650}, Category: "Town", Builtin: true},
+	{Name: "Louisville", Coordinates: Coordinates{X: 12600, Y: 2050}, Category: "Town", Builtin: true},
+}
+
+var locations []Location
+
+func locations_init() error {
+	locations = slices.Clone(builtinLocations)
+
+	locationsFilePath := filepath.Join(get_server_folder(), "locations.json")
+	if !file_exists(locationsFilePath) {
+		return nil
+	}
+
+	err := readJSON(locationsFilePath, &locations)
+	if err != nil {
+		locations = slices.Clone(builtinLocations)
+		return errors.New("Error reading locations file: " + err.Error())
+	}
+
+	return nil
+}
+
+func locations_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "locations.json"), locations)
+}
+
+func find_location(name string) *Location {
+	for i := range locations {
+		if strings.EqualFold(locations[i].Name, strings.TrimSpace(name)) {
+			return &locations[i]
+		}
+	}
+
+	return nil
+}
+
+// resolve_coordinates replaces the coordinates with the ones of the named location, if
+// a location is given, and reports unknown locations
+func resolve_coordinates(coordinates *Coordinates) bool {
+	if coordinates.Location == "" {
+		return true
+	}
+
+	location := find_location(coordinates.Location)
+	if location == nil {
+		runtime.LogWarningf(app.ctx, "Unknown location: %s", coordinates.Location)
+		app.SendNotification(Notification{
+			Title:   "rcon.locations.unknown_location",
+			Variant: "error",
+			Parameters: map[string]string{
+				"name": coordinates.Location,
+			},
+		})
+		return false
+	}
+
+	*coordinates = location.Coordinates
+	return true
+}
+
+func validate_location(location Location) error {
+	switch {
+	case location.Name == "":
+		return errors.New("location name is empty")
+	case location.Coordinates.X <= 0 || location.Coordinates.Y <= 0:
+		return errors.New("coordinates must be greater than 0")
+	case location.Coordinates.Z < 0 || location.Coordinates.Z > 7:
+		return errors.New("z must be between 0 and 7")
+	}
+
+	return nil
+}
+
+// locations_writable tells if the locations can be changed, they are saved in the folder of
+// the connected server
+func locations_writable() bool {
+	if !app.IsRconConnected() {
+		runtime.LogWarning(app.ctx, "Not changing locations, "+errRconNotConnected)
+		return false
+	}
+
+	return true
+}
+
+func locations_changed() string {
+	err := locations_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving locations: "+err.Error())
+		return err.Error()
+	}
+	runtime.EventsEmit(app.ctx, "update-locations", locations)
+
+	return ""
+}
+
+func (app *App) GetLocations() []Location {
+	return locations
+}
+
+// SaveLocation adds or replaces a location, previousName renames an existing one,
+// returns an empty string on success or the error
+func (app *App) SaveLocation(location Location, previousName string) string {
+	location.Name = strings.TrimSpace(location.Name)
+	location.Category = strings.TrimSpace(location.Category)
+	location.Coordinates.Location = ""
+	if err := validate_location(location); err != nil {
+		return err.Error()
+	}
+	if !locations_writable() {
+		return errRconNotConnected
+	}
+
+	if previousName == "" {
+		previousName = location.Name
+	}
+	if existing := find_location(location.Name); existing != nil && !strings.EqualFold(location.Name, previousName) {
+		return fmt.Sprintf("a location named %s already exists", location.Name)
+	}
+
+	if existing := find_location(previousName); existing != nil {
+		location.Builtin = existing.Builtin && existing.Coordinates == location.Coordinates
+		*existing = location
+	} else {
+		location.Builtin = false
+		locations = append(locations, location)
+	}
+
+	return locations_changed()
+}
+
+func (app *App) DeleteLocation(name string) bool {
+	if !locations_writable() {
+		return false
+	}
+
+	index := slices.IndexFunc(locations, func(location Location) bool { return strings.EqualFold(location.Name, name) })
+	if index == -1 {
+		return false
+	}
+	locations = slices.Delete(locations, index, index+1)
+
+	return locations_changed() == ""
+}
+
+// RestoreBuiltinLocations adds the built-in locations that were deleted or renamed
+func (app *App) RestoreBuiltinLocations() int {
+	if !locations_writable() {
+		return 0
+	}
+
+	restored := 0
+	for _, builtin := range builtinLocations {
+		if find_location(builtin.Name) == nil {
+			locations = append(locations, builtin)
+			restored++
+		}
+	}
+
+	if restored > 0 {
+		locations_changed()
+	}
+
+	return restored
+}
+
+func (app *App) ImportLocationsDialog() int {
+	if !locations_writable() {
+		app.SendNotification(Notification{
+			Title:   "rcon.locations.error_importing",
+			Message: errRconNotConnected,
+			Variant: "error",
+		})
+		return 0
+	}
+
+	path, err := runtime.OpenFileDialog(app.ctx, runtime.OpenDialogOptions{
+		Title: "Import locations",
+		Filters: []runtime.FileFilter{
+			{
+				DisplayName: "JSON",
+				Pattern:     "*.json",
+			},
+		},
+	})
+
+	if path == "" {
+		runtime.LogInfo(app.ctx, "No path given, not importing locations")
+		return 0
+	}
+
+	var imported []Location
+	if err == nil {
+		err = readJSON(path, &imported)
+	}
+	if err != nil {
+		runtime.LogWarning(app.ctx, err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.locations.error_importing",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return 0
+	}
+
+	// Imported locations replace the ones with the same name
+	count := 0
+	for _, location := range imported {
+		location.Name = strings.TrimSpace(location.Name)
+		location.Coordinates.Location = ""
+		location.Builtin = false
+		if validate_location(location) != nil {
+			runtime.LogWarningf(app.ctx, "Skipping invalid location: %s", location.Name)
+			continue
+		}
+
+		if existing := find_location(location.Name); existing != nil {
+			*existing = location
+		} else {
+			locations = append(locations, location)
+		}
+		count++
+	}
+	locations_changed()
+
+	runtime.LogInfof(app.ctx, "Imported %d locations from %s", count, path)
+	app.SendNotification(Notification{
+		Title:   "rcon.locations.imported",
+		Variant: "success",
+		Parameters: map[string]string{
+			"n": fmt.Sprintf("%d", count),
+		},
+	})
+
+	return count
+}
+
+func (app *App) ExportLocationsDialog() {
+	path, err := runtime.SaveFileDialog(app.ctx, runtime.SaveDialogOptions{
+		Title:                "Export locations",
+		DefaultFilename:      "locations.json",
+		CanCreateDirectories: true,
+		Filters: []runtime.FileFilter{
+			{
+				DisplayName: "JSON",
+				Pattern:     "*.json",
+			},
+		},
+	})
+
+	if path == "" {
+		runtime.LogInfo(app.ctx, "No path given, not exporting locations")
+		return
+	}
+
+	if err == nil {
+		err = writeJSON(path, locations)
+	}
+	if err != nil {
+		runtime.LogWarning(app.ctx, err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.locations.error_exporting",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return
+	}
+
+	runtime.LogInfo(app.ctx, "Locations exported to "+path)
+	app.SendNotification(Notification{
+		Title:   "rcon.locations.exported",
+		Path:    path,
+		Variant: "success",
+	})
+}
@@ -0,0 +1,246 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+var (
+	metricsRegistry = prometheus.NewRegistry()
+
+	metricsPlayersOnline = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pzadmin",
+		Name:      "players_online",
+		Help:      "Number of players online.",
+	}, []string{"server"})
+
+	metricsConnected = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "pzadmin",
+		Name:      "rcon_connected",
+		Help:      "Whether the RCON connection to the server is up (1) or down (0).",
+	}, []string{"server"})
+
+	metricsLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "pzadmin",
+		Name:      "rcon_request_duration_seconds",
+		Help:      "Round trip time of RCON commands.",
+		Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	}, []string{"server"})
+
+	metricsErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "rcon_errors_total",
+		Help:      "Number of RCON commands that failed to execute.",
+	}, []string{"server"})
+
+	metricsReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "rcon_reconnects_total",
+		Help:      "Number of times the RCON connection was re-established.",
+	}, []string{"server"})
+
+	metricsCommands = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "rcon_commands_total",
+		Help:      "Number of RCON commands sent, by command.",
+	}, []string{"server", "command"})
+
+	metricsBans = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "bans_total",
+		Help:      "Number of players banned.",
+	}, []string{"server"})
+
+	metricsUnbans = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "unbans_total",
+		Help:      "Number of players unbanned.",
+	}, []string{"server"})
+
+	metricsKicks = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "kicks_total",
+		Help:      "Number of players kicked.",
+	}, []string{"server"})
+
+	metricsOptionsChanges = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "pzadmin",
+		Name:      "options_changes_total",
+		Help:      "Number of times the server options were seen to change.",
+	}, []string{"server"})
+)
+
+var (
+	metricsMutex  sync.Mutex
+	metricsServer *http.Server
+)
+
+func init() {
+	metricsRegistry.MustRegister(
+		metricsPlayersOnline,
+		metricsConnected,
+		metricsLatency,
+		metricsErrors,
+		metricsReconnects,
+		metricsCommands,
+		metricsBans,
+		metricsUnbans,
+		metricsKicks,
+		metricsOptionsChanges,
+	)
+}
+
+// command_name returns the command without its arguments, keeping the label cardinality low
+func command_name(command string) string {
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return strings.ToLower(fields[0])
+}
+
+func metrics_record_request(command string, latency time.Duration, failed bool) {
+	server := get_server_id()
+
+	metricsCommands.WithLabelValues(server, command_name(command)).Inc()
+	if failed {
+		metricsErrors.WithLabelValues(server).Inc()
+		return
+	}
+	metricsLatency.WithLabelValues(server).Observe(latency.Seconds())
+}
+
+// metrics_record_success counts the moderation actions of a command that succeeded
+func metrics_record_success(command string) {
+	switch command_name(command) {
+	case "banuser", "banid":
+		metricsBans.WithLabelValues(get_server_id()).Inc()
+	case "unbanuser", "unbanid":
+		metricsUnbans.WithLabelValues(get_server_id()).Inc()
+	case "kick", "kickuser":
+		metricsKicks.WithLabelValues(get_server_id()).Inc()
+	}
+}
+
+func metrics_set_players_online(online int) {
+	metricsPlayersOnline.WithLabelValues(get_server_id()).Set(float64(online))
+}
+
+func metrics_set_connected(connected bool) {
+	value := 0.0
+	if connected {
+		value = 1
+	}
+	metricsConnected.WithLabelValues(get_server_id()).Set(value)
+}
+
+func metrics_record_reconnect() {
+	metricsReconnects.WithLabelValues(get_server_id()).Inc()
+}
+
+func metrics_record_options_change() {
+	metricsOptionsChanges.WithLabelValues(get_server_id()).Inc()
+}
+
+func metrics_init() {
+	if *config.EnableMetrics {
+		err := start_metrics_server()
+		if err != nil {
+			runtime.LogError(appContext, "Error starting metrics server: "+err.Error())
+		}
+	}
+}
+
+func start_metrics_server() error {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	if metricsServer != nil {
+		return errors.New("metrics server is already running")
+	}
+
+	listener, err := net.Listen("tcp", *config.MetricsAddress)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{}))
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	metricsServer = server
+
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			runtime.LogError(appContext, "Metrics server stopped: "+err.Error())
+		}
+	}()
+	runtime.LogInfo(appContext, "Serving metrics on http://"+listener.Addr().String()+"/metrics")
+
+	return nil
+}
+
+func stop_metrics_server() error {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	if metricsServer == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := metricsServer.Shutdown(ctx)
+	metricsServer = nil
+	runtime.LogInfo(appContext, "Metrics server stopped")
+
+	return err
+}
+
+// ApplyMetricsConfig starts, stops or restarts the metrics server after the
+// EnableMetrics or MetricsAddress settings changed
+func (app *App) ApplyMetricsConfig() bool {
+	err := stop_metrics_server()
+	if err != nil {
+		runtime.LogWarning(app.ctx, "Error stopping metrics server: "+err.Error())
+	}
+
+	if !*config.EnableMetrics {
+		return true
+	}
+
+	err = start_metrics_server()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error starting metrics server: "+err.Error())
+		app.SendNotification(Notification{
+			Title:   "settings.setting.metrics.error_starting",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return false
+	}
+
+	return true
+}
+
+func (app *App) IsMetricsServerRunning() bool {
+	metricsMutex.Lock()
+	defer metricsMutex.Unlock()
+
+	return metricsServer != nil
+}
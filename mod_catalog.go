@@ -0,0 +1,436 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Translate folders of the game and their locales
+var translationLanguages = map[string]string{
+	"EN": "en-US",
+	"RU": "ru-RU",
+	"TR": "tr-TR",
+	"UA": "uk-UA",
+}
+
+var modTranslationRegex = regexp.MustCompile(`([\w.]+)\s*=\s*"(.*)"`)
+var scriptCommentRegex = regexp.MustCompile(`(?s)/\*.*?\*/|//[^\n]*`)
+
+// ModCatalog holds the items and vehicles imported from mod scripts, kept per server and
+// searched on top of the game catalog
+type ModCatalog struct {
+	Sources      []string                     `json:"sources"` // Imported folders
+	Mods         []string                     `json:"mods"`
+	Modules      []string                     `json:"modules"` // Script modules, the prefix of the IDs
+	Items        []CatalogEntry               `json:"items"`
+	Vehicles     []CatalogEntry               `json:"vehicles"`
+	Translations map[string]map[string]string `json:"translations"` // Locale to item names by ID
+}
+
+// scriptBlock is an item or vehicle block of a script file
+type scriptBlock struct {
+	Kind       string // item, vehicle
+	Module     string
+	Name       string
+	Properties map[string]string // Lowercase keys
+}
+
+var modCatalog ModCatalog
+
+func empty_mod_catalog() ModCatalog {
+	return ModCatalog{
+		Sources:      []string{},
+		Mods:         []string{},
+		Modules:      []string{},
+		Items:        []CatalogEntry{},
+		Vehicles:     []CatalogEntry{},
+		Translations: map[string]map[string]string{},
+	}
+}
+
+func mod_catalog_init() error {
+	modCatalog = empty_mod_catalog()
+	defer mod_catalog_apply()
+
+	modCatalogFilePath := filepath.Join(get_server_folder(), "mod_catalog.json")
+	if !file_exists(modCatalogFilePath) {
+		return nil
+	}
+
+	err := readJSON(modCatalogFilePath, &modCatalog)
+	if err != nil {
+		modCatalog = empty_mod_catalog()
+		return errors.New("Error reading mod catalog file: " + err.Error())
+	}
+
+	return nil
+}
+
+func mod_catalog_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "mod_catalog.json"), modCatalog)
+}
+
+func mod_catalog_apply() {
+	catalog_apply_overlay(modCatalog.Items, modCatalog.Vehicles, modCatalog.Modules, modCatalog.Translations)
+	runtime.EventsEmit(app.ctx, "update-mod-catalog", modCatalog)
+}
+
+// parse_script_blocks reads the item and vehicle blocks of a script file, e.g.
+// module Base { item Axe { DisplayName = Axe, } }
+func parse_script_blocks(content string) []scriptBlock {
+	content = scriptCommentRegex.ReplaceAllString(content, "")
+
+	type frame struct {
+		header     string
+		properties strings.Builder
+	}
+	stack := []*frame{}
+	blocks := []scriptBlock{}
+	var buf strings.Builder
+
+	for _, r := range content {
+		switch r {
+		case '{':
+			// The header is the last statement before the brace, the rest belongs to the parent
+			text := buf.String()
+			index := strings.LastIndexAny(strings.TrimRight(text, " \t\r\n"), ",\n")
+			if len(stack) > 0 {
+				stack[len(stack)-1].properties.WriteString(text[:index+1])
+			}
+			stack = append(stack, &frame{header: strings.TrimSpace(text[index+1:])})
+			buf.Reset()
+		case '}':
+			if len(stack) == 0 {
+				buf.Reset()
+				continue
+			}
+			current := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			current.properties.WriteString(buf.String())
+			buf.Reset()
+
+			fields := strings.Fields(current.header)
+			if len(fields) != 2 || len(stack) == 0 {
+				continue
+			}
+			kind := strings.ToLower(fields[0])
+			parent := strings.Fields(stack[len(stack)-1].header)
+			if (kind != "item" && kind != "vehicle") || len(parent) != 2 || !strings.EqualFold(parent[0], "module") {
+				continue
+			}
+
+			properties := map[string]string{}
+			for _, statement := range strings.FieldsFunc(current.properties.String(), func(r rune) bool { return r == ',' || r == '\n' }) {
+				if key, value, ok := strings.Cut(statement, "="); ok {
+					properties[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+				}
+			}
+			blocks = append(blocks, scriptBlock{Kind: kind, Module: parent[1], Name: fields[1], Properties: properties})
+		default:
+			buf.WriteRune(r)
+		}
+	}
+
+	return blocks
+}
+
+// mod_info_name reads the name of a mod from its mod.info file
+func mod_info_name(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if key, value, ok := strings.Cut(scanner.Text(), "="); ok && strings.TrimSpace(key) == "name" {
+			return strings.TrimSpace(value)
+		}
+	}
+
+	return ""
+}
+
+// scan_mod_catalog reads the media/scripts and Translate folders of a game install,
+// workshop content folder or a single mod
+func scan_mod_catalog(folder string) (ModCatalog, error) {
+	result := empty_mod_catalog()
+	result.Sources = append(result.Sources, folder)
+
+	modNames := map[string]string{} // Mod folder to name
+	scripts := []string{}
+	translations := map[string]map[string]string{} // Locale to translation keys
+
+	err := filepath.WalkDir(folder, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			runtime.LogWarningf(app.ctx, "Skipping %s: %s", path, err.Error())
+			return nil
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		slashPath := filepath.ToSlash(path)
+		switch {
+		case entry.Name() == "mod.info":
+			if name := mod_info_name(path); name != "" {
+				modNames[filepath.Dir(path)] = name
+			}
+		case !strings.EqualFold(filepath.Ext(path), ".txt"):
+		case strings.Contains(slashPath, "/media/scripts/"):
+			scripts = append(scripts, path)
+		case filepath.Base(filepath.Dir(filepath.Dir(path))) == "Translate":
+			locale, ok := translationLanguages[filepath.Base(filepath.Dir(path))]
+			if !ok {
+				return nil
+			}
+
+			content, err := os.ReadFile(path)
+			if err == nil {
+				content, err = decode_translation(content, gameTranslationEncodings[locale])
+			}
+			if err != nil {
+				runtime.LogWarningf(app.ctx, "Skipping %s: %s", path, err.Error())
+				return nil
+			}
+
+			if translations[locale] == nil {
+				translations[locale] = map[string]string{}
+			}
+			for _, match := range modTranslationRegex.FindAllSubmatch(content, -1) {
+				translations[locale][string(match[1])] = string(match[2])
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	english := translations["en-US"]
+	for _, script := range scripts {
+		content, err := os.ReadFile(script)
+		if err != nil {
+			runtime.LogWarningf(app.ctx, "Skipping %s: %s", script, err.Error())
+			continue
+		}
+
+		// The mod folder contains the media folder
+		modFolder := filepath.Dir(script[:strings.Index(filepath.ToSlash(script), "/media/scripts/")+1])
+		modName := modNames[modFolder]
+		if modName != "" {
+			result.Mods = append_unique(result.Mods, modName)
+		}
+
+		for _, block := range parse_script_blocks(string(content)) {
+			if strings.EqualFold(block.Properties["obsolete"], "true") {
+				continue
+			}
+
+			id := block.Module + "." + block.Name
+			result.Modules = append_unique(result.Modules, block.Module)
+
+			if block.Kind == "vehicle" {
+				name := block.Name
+				if translated, ok := english["IGUI_VehicleName"+block.Name]; ok {
+					name = translated
+				}
+				category := modName
+				if category == "" {
+					category = "Modded"
+				}
+				result.Vehicles = append(result.Vehicles, CatalogEntry{Id: id, Name: name, Category: category, Images: []string{}})
+				continue
+			}
+
+			name := block.Properties["displayname"]
+			if translated, ok := english["ItemName_"+id]; ok {
+				name = translated
+			}
+			if name == "" {
+				name = block.Name
+			}
+
+			category := block.Properties["displaycategory"]
+			if category == "" {
+				category = block.Properties["type"]
+			}
+			if translated, ok := english["IGUI_ItemCat_"+category]; ok {
+				category = translated
+			}
+			if category == "" {
+				category = "Modded"
+			}
+
+			result.Items = append(result.Items, CatalogEntry{Id: id, Name: name, Category: category, Images: []string{}})
+
+			for locale, keys := range translations {
+				if translated, ok := keys["ItemName_"+id]; ok {
+					if result.Translations[locale] == nil {
+						result.Translations[locale] = map[string]string{}
+					}
+					result.Translations[locale][id] = translated
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func append_unique(values []string, added ...string) []string {
+	for _, value := range added {
+		if !slices.Contains(values, value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+// merge_catalog_entries adds the entries, replacing the ones with the same ID
+func merge_catalog_entries(entries []CatalogEntry, added []CatalogEntry) []CatalogEntry {
+	for _, entry := range added {
+		index := slices.IndexFunc(entries, func(e CatalogEntry) bool { return strings.EqualFold(e.Id, entry.Id) })
+		if index >= 0 {
+			entries[index] = entry
+		} else {
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries
+}
+
+func (app *App) GetModCatalog() ModCatalog {
+	return modCatalog
+}
+
+// ImportModCatalogDialog scans a folder for mod scripts and adds their items and vehicles
+// to the mod catalog of the server, returns the number of imported items and vehicles
+func (app *App) ImportModCatalogDialog() int {
+	// The catalog is saved in the folder of the connected server
+	if !app.IsRconConnected() {
+		runtime.LogWarning(app.ctx, "Not importing mod items, "+errRconNotConnected)
+		app.SendNotification(Notification{
+			Title:   "rcon.modCatalog.error_importing",
+			Message: errRconNotConnected,
+			Variant: "error",
+		})
+		return 0
+	}
+
+	folder, err := runtime.OpenDirectoryDialog(app.ctx, runtime.OpenDialogOptions{
+		Title: "Import mod items and vehicles",
+	})
+
+	if folder == "" {
+		runtime.LogInfo(app.ctx, "No path given, not importing mod items")
+		return 0
+	}
+
+	var imported ModCatalog
+	if err == nil {
+		imported, err = scan_mod_catalog(folder)
+	}
+	if err != nil {
+		runtime.LogWarning(app.ctx, err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.modCatalog.error_importing",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return 0
+	}
+
+	if len(imported.Items) == 0 && len(imported.Vehicles) == 0 {
+		runtime.LogWarningf(app.ctx, "No items or vehicles found in %s", folder)
+		app.SendNotification(Notification{
+			Title:   "rcon.modCatalog.nothing_found",
+			Variant: "warning",
+		})
+		return 0
+	}
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	// The connection may have been closed while the folder was scanned
+	if conn == nil {
+		app.SendNotification(Notification{
+			Title:   "rcon.modCatalog.error_importing",
+			Message: errRconNotConnected,
+			Variant: "error",
+		})
+		return 0
+	}
+
+	modCatalog.Sources = append_unique(modCatalog.Sources, imported.Sources...)
+	modCatalog.Mods = append_unique(modCatalog.Mods, imported.Mods...)
+	modCatalog.Modules = append_unique(modCatalog.Modules, imported.Modules...)
+	modCatalog.Items = merge_catalog_entries(modCatalog.Items, imported.Items)
+	modCatalog.Vehicles = merge_catalog_entries(modCatalog.Vehicles, imported.Vehicles)
+	for locale, names := range imported.Translations {
+		if modCatalog.Translations[locale] == nil {
+			modCatalog.Translations[locale] = map[string]string{}
+		}
+		for id, name := range names {
+			modCatalog.Translations[locale][id] = name
+		}
+	}
+
+	err = mod_catalog_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving mod catalog: "+err.Error())
+	}
+	mod_catalog_apply()
+
+	runtime.LogInfof(app.ctx, "Imported %d items and %d vehicles of %d mods from %s", len(imported.Items), len(imported.Vehicles), len(imported.Mods), folder)
+	app.SendNotification(Notification{
+		Title:   "rcon.modCatalog.imported",
+		Variant: "success",
+		Parameters: map[string]string{
+			"items":    fmt.Sprintf("%d", len(imported.Items)),
+			"vehicles": fmt.Sprintf("%d", len(imported.Vehicles)),
+		},
+	})
+
+	return len(imported.Items) + len(imported.Vehicles)
+}
+
+// ClearModCatalog removes the imported mod items and vehicles of the server
+func (app *App) ClearModCatalog() bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	if conn == nil {
+		return false
+	}
+
+	modCatalog = empty_mod_catalog()
+
+	err := mod_catalog_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving mod catalog: "+err.Error())
+		return false
+	}
+	mod_catalog_apply()
+
+	return true
+}
@@ -0,0 +1,141 @@
+package main
+
+// pzOptionsSchema is the type of each known server option, options missing from the
+// schema get a type inferred from their value
+var pzOptionsSchema = []PzOptionSchema{
+	{Name: "AdminSafehouse", Type: OptionTypeBool},
+	{Name: "AllowCoop", Type: OptionTypeBool},
+	{Name: "AllowDestructionBySledgehammer", Type: OptionTypeBool},
+	{Name: "AllowNonAsciiUsername", Type: OptionTypeBool},
+	{Name: "AnnounceDeath", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType1", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType2", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType3", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType4", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType5", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType6", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType7", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType8", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType9", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType10", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType11", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType12", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType13", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType14", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType15", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType16", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType17", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType18", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType19", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType20", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType21", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType22", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType23", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType24", Type: OptionTypeBool},
+	{Name: "AntiCheatProtectionType2ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType3ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType4ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType9ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType15ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType20ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType22ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AntiCheatProtectionType24ThresholdMultiplier", Type: OptionTypeFloat},
+	{Name: "AutoCreateUserInWhiteList", Type: OptionTypeBool},
+	{Name: "BackupsCount", Type: OptionTypeInt},
+	{Name: "BackupsOnStart", Type: OptionTypeBool},
+	{Name: "BackupsOnVersionChange", Type: OptionTypeBool},
+	{Name: "BackupsPeriod", Type: OptionTypeInt},
+	{Name: "BanKickGlobalSound", Type: OptionTypeBool},
+	{Name: "BloodSplatLifespanDays", Type: OptionTypeInt},
+	{Name: "CarEngineAttractionModifier", Type: OptionTypeFloat},
+	{Name: "ChatStreams", Type: OptionTypeString},
+	{Name: "ClientActionLogs", Type: OptionTypeString},
+	{Name: "ClientCommandFilter", Type: OptionTypeString},
+	{Name: "ConstructionPreventsLootRespawn", Type: OptionTypeBool},
+	{Name: "DefaultPort", Type: OptionTypeInt},
+	{Name: "DenyLoginOnOverloadedServer", Type: OptionTypeBool},
+	{Name: "DisableRadioAdmin", Type: OptionTypeBool},
+	{Name: "DisableRadioGM", Type: OptionTypeBool},
+	{Name: "DisableRadioInvisible", Type: OptionTypeBool},
+	{Name: "DisableRadioModerator", Type: OptionTypeBool},
+	{Name: "DisableRadioOverseer", Type: OptionTypeBool},
+	{Name: "DisableRadioStaff", Type: OptionTypeBool},
+	{Name: "DisableSafehouseWhenPlayerConnected", Type: OptionTypeBool},
+	{Name: "DiscordEnable", Type: OptionTypeBool},
+	{Name: "DiscordToken", Type: OptionTypeString},
+	{Name: "DiscordChannel", Type: OptionTypeString},
+	{Name: "DiscordChannelID", Type: OptionTypeString},
+	{Name: "DisplayUserName", Type: OptionTypeBool},
+	{Name: "DoLuaChecksum", Type: OptionTypeBool},
+	{Name: "DropOffWhiteListAfterDeath", Type: OptionTypeBool},
+	{Name: "Faction", Type: OptionTypeBool},
+	{Name: "FactionDaySurvivedToCreate", Type: OptionTypeInt},
+	{Name: "FactionPlayersRequiredForTag", Type: OptionTypeInt},
+	{Name: "FastForwardMultiplier", Type: OptionTypeFloat},
+	{Name: "GlobalChat", Type: OptionTypeBool},
+	{Name: "HidePlayersBehindYou", Type: OptionTypeBool},
+	{Name: "HoursForLootRespawn", Type: OptionTypeInt},
+	{Name: "ItemNumbersLimitPerContainer", Type: OptionTypeInt},
+	{Name: "KickFastPlayers", Type: OptionTypeBool},
+	{Name: "KnockedDownAllowed", Type: OptionTypeBool},
+	{Name: "LoginQueueConnectTimeout", Type: OptionTypeInt},
+	{Name: "LoginQueueEnabled", Type: OptionTypeBool},
+	{Name: "Map", Type: OptionTypeString},
+	{Name: "MapRemotePlayerVisibility", Type: OptionTypeInt},
+	{Name: "MaxAccountsPerUser", Type: OptionTypeInt},
+	{Name: "MaxItemsForLootRespawn", Type: OptionTypeInt},
+	{Name: "MaxPlayers", Type: OptionTypeInt},
+	{Name: "MinutesPerPage", Type: OptionTypeFloat},
+	{Name: "Mods", Type: OptionTypeString},
+	{Name: "MouseOverToSeeDisplayName", Type: OptionTypeBool},
+	{Name: "NoFire", Type: OptionTypeBool},
+	{Name: "Open", Type: OptionTypeBool},
+	{Name: "PVP", Type: OptionTypeBool},
+	{Name: "PVPFirearmDamageModifier", Type: OptionTypeFloat},
+	{Name: "PVPMeleeDamageModifier", Type: OptionTypeFloat},
+	{Name: "PVPMeleeWhileHitReaction", Type: OptionTypeBool},
+	{Name: "PauseEmpty", Type: OptionTypeBool},
+	{Name: "PerkLogs", Type: OptionTypeBool},
+	{Name: "PingLimit", Type: OptionTypeInt},
+	{Name: "PlayerBumpPlayer", Type: OptionTypeBool},
+	{Name: "PlayerRespawnWithOther", Type: OptionTypeBool},
+	{Name: "PlayerRespawnWithSelf", Type: OptionTypeBool},
+	{Name: "PlayerSafehouse", Type: OptionTypeBool},
+	{Name: "Public", Type: OptionTypeBool},
+	{Name: "PublicDescription", Type: OptionTypeString},
+	{Name: "PublicName", Type: OptionTypeString},
+	{Name: "RemovePlayerCorpsesOnCorpseRemoval", Type: OptionTypeBool},
+	{Name: "ResetID", Type: OptionTypeInt},
+	{Name: "SafeHouseRemovalTime", Type: OptionTypeInt},
+	{Name: "SafehouseAllowFire", Type: OptionTypeBool},
+	{Name: "SafehouseAllowLoot", Type: OptionTypeBool},
+	{Name: "SafehouseAllowNonResidential", Type: OptionTypeBool},
+	{Name: "SafehouseAllowRespawn", Type: OptionTypeBool},
+	{Name: "SafehouseAllowTrepass", Type: OptionTypeBool},
+	{Name: "SafehouseDaySurvivedToClaim", Type: OptionTypeInt},
+	{Name: "SafetyCooldownTimer", Type: OptionTypeInt},
+	{Name: "SafetySystem", Type: OptionTypeBool},
+	{Name: "SafetyToggleTimer", Type: OptionTypeInt},
+	{Name: "SaveWorldEveryMinutes", Type: OptionTypeInt},
+	{Name: "ServerPlayerID", Type: OptionTypeInt},
+	{Name: "ShowFirstAndLastName", Type: OptionTypeBool},
+	{Name: "ShowSafety", Type: OptionTypeBool},
+	{Name: "SledgehammerOnlyInSafehouse", Type: OptionTypeBool},
+	{Name: "SleepAllowed", Type: OptionTypeBool},
+	{Name: "SleepNeeded", Type: OptionTypeBool},
+	{Name: "SneakModeHideFromOtherPlayers", Type: OptionTypeBool},
+	{Name: "SpawnItems", Type: OptionTypeString},
+	{Name: "SpawnPoint", Type: OptionTypeString},
+	{Name: "SpeedLimit", Type: OptionTypeFloat},
+	{Name: "SteamScoreboard", Type: OptionTypeBool},
+	{Name: "SteamVAC", Type: OptionTypeBool},
+	{Name: "TrashDeleteAll", Type: OptionTypeBool},
+	{Name: "UDPPort", Type: OptionTypeInt},
+	{Name: "UPnP", Type: OptionTypeBool},
+	{Name: "Voice3D", Type: OptionTypeBool},
+	{Name: "VoiceEnable", Type: OptionTypeBool},
+	{Name: "VoiceMaxDistance", Type: OptionTypeFloat},
+	{Name: "VoiceMinDistance", Type: OptionTypeFloat},
+	{Name: "WorkshopItems", Type: OptionTypeString},
+	{Name: "ServerWelcomeMessage", Type: OptionTypeString},
+}
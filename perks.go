@@ -0,0 +1,146 @@
+package main
+
+import (
+	"math"
+	"slices"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const maxPerkLevel = 10
+
+// XP needed to go from level n to n+1, index 0 is level 0 to 1
+var perkLevelXp = []int{75, 150, 300, 750, 1500, 3000, 4500, 6000, 7500, 9000}
+
+// Fitness and Strength level up much slower
+var passivePerkLevelXp = []int{1500, 3000, 6000, 9000, 18000, 30000, 60000, 90000, 120000, 150000}
+
+var passivePerks = []string{"Fitness", "Strength"}
+
+// XP multiplier of a skill by its boost from the profession and traits, XP of skills
+// without a boost is reduced to a quarter. Passive skills are not affected
+var perkBoostMultipliers = []float64{0.25, 1, 1.33, 1.66}
+
+// PerkLevelRequest describes a level change, RCON can't read skill levels so the
+// current level has to be given
+type PerkLevelRequest struct {
+	Perks        []string `json:"perks"`
+	CurrentLevel int      `json:"currentLevel"`
+	Level        int      `json:"level"`      // Target level for SetPerkLevel, levels to add for AddPerkLevels
+	Multiplier   float64  `json:"multiplier"` // Server XP multiplier to compensate, 0 or 1 for none
+
+	// Compensate the XP multiplier of the skill boost from the profession and traits, 0-3
+	CompensateBoost bool `json:"compensateBoost"`
+	Boost           int  `json:"boost"`
+}
+
+type PerkXpPreview struct {
+	Perk      string `json:"perk"`
+	FromLevel int    `json:"fromLevel"`
+	ToLevel   int    `json:"toLevel"`
+	Xp        int    `json:"xp"`
+}
+
+// perk_xp returns the XP between two levels of the perk
+func perk_xp(perk string, fromLevel int, toLevel int) int {
+	table := perkLevelXp
+	if slices.Contains(passivePerks, perk) {
+		table = passivePerkLevelXp
+	}
+
+	xp := 0
+	for level := max(fromLevel, 0); level < min(toLevel, maxPerkLevel); level++ {
+		xp += table[level]
+	}
+
+	return xp
+}
+
+// perk_xp_amount is the XP to send so the player gains xp after the game applies the multipliers
+func perk_xp_amount(perk string, xp int, request PerkLevelRequest) int {
+	multiplier := 1.0
+	if request.Multiplier > 0 {
+		multiplier = request.Multiplier
+	}
+	if request.CompensateBoost && !slices.Contains(passivePerks, perk) {
+		multiplier *= perkBoostMultipliers[max(0, min(request.Boost, len(perkBoostMultipliers)-1))]
+	}
+
+	return int(math.Ceil(float64(xp) / multiplier))
+}
+
+func perk_xp_preview(request PerkLevelRequest, toLevel int) []PerkXpPreview {
+	fromLevel := max(0, min(request.CurrentLevel, maxPerkLevel))
+	toLevel = max(fromLevel, min(toLevel, maxPerkLevel))
+
+	previews := make([]PerkXpPreview, 0, len(request.Perks))
+	for _, perk := range request.Perks {
+		previews = append(previews, PerkXpPreview{
+			Perk:      perk,
+			FromLevel: fromLevel,
+			ToLevel:   toLevel,
+			Xp:        perk_xp_amount(perk, perk_xp(perk, fromLevel, toLevel), request),
+		})
+	}
+
+	return previews
+}
+
+// PreviewPerkLevels returns the XP sent per perk, add selects AddPerkLevels over SetPerkLevel
+func (app *App) PreviewPerkLevels(request PerkLevelRequest, add bool) []PerkXpPreview {
+	if add {
+		return perk_xp_preview(request, request.CurrentLevel+request.Level)
+	}
+
+	return perk_xp_preview(request, request.Level)
+}
+
+func (app *App) send_perk_xp(names []string, previews []PerkXpPreview) {
+	successCount, sent := 0, 0
+	for _, preview := range previews {
+		if preview.Xp <= 0 {
+			continue
+		}
+
+		sent++
+		successCount += add_xp(names, preview.Perk, preview.Xp)
+		runtime.LogInfof(app.ctx, "Sent %d %s xp for level %d to %d", preview.Xp, preview.Perk, preview.FromLevel, preview.ToLevel)
+	}
+
+	switch {
+	case sent == 0:
+		app.SendNotification(Notification{
+			Title:   "rcon.perkLevels.nothing_to_add",
+			Variant: "warning",
+		})
+	case successCount > 0:
+		app.SendNotification(Notification{
+			Title:   "rcon.addXP.success",
+			Variant: "success",
+		})
+	default:
+		app.SendNotification(Notification{
+			Title:   "rcon.addXP.fail",
+			Variant: "error",
+		})
+	}
+}
+
+// SetPerkLevel raises the perks from the current level to the level, XP can't be removed
+// so lower levels are ignored
+func (app *App) SetPerkLevel(names []string, request PerkLevelRequest) {
+	if len(request.Perks) == 0 {
+		return
+	}
+
+	app.send_perk_xp(names, perk_xp_preview(request, request.Level))
+}
+
+// AddPerkLevels raises the perks by the given number of levels from the current level
+func (app *App) AddPerkLevels(names []string, request PerkLevelRequest) {
+	if len(request.Perks) == 0 {
+		return
+	}
+
+	app.send_perk_xp(names, perk_xp_preview(request, request.CurrentLevel+request.Level))
+}
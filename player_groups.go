@@ -0,0 +1,203 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Player names starting with this prefix refer to a group, e.g. "@group:staff"
+const GroupReferencePrefix = "@group:"
+
+const (
+	PlayerGroupStatic  = "static"  // Fixed list of members
+	PlayerGroupDynamic = "dynamic" // Members are the players matching the rule
+)
+
+type PlayerGroup struct {
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`    // static, dynamic
+	Members []string `json:"members"` // Only used by static groups
+	Rule    string   `json:"rule"`    // Only used by dynamic groups, e.g. online AND tag=donor
+}
+
+var playerGroups []PlayerGroup
+
+func player_groups_init() error {
+	playerGroups = []PlayerGroup{}
+
+	groupsFilePath := filepath.Join(get_server_folder(), "groups.json")
+	if !file_exists(groupsFilePath) {
+		return nil
+	}
+
+	err := readJSON(groupsFilePath, &playerGroups)
+	if err != nil {
+		playerGroups = []PlayerGroup{}
+		return errors.New("Error reading groups file: " + err.Error())
+	}
+
+	return nil
+}
+
+func player_groups_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "groups.json"), playerGroups)
+}
+
+func find_player_group(name string) *PlayerGroup {
+	for i := range playerGroups {
+		if strings.EqualFold(playerGroups[i].Name, name) {
+			return &playerGroups[i]
+		}
+	}
+
+	return nil
+}
+
+// resolve_player_group returns the current members of a group, must be called with connMutex held
+func resolve_player_group(group PlayerGroup) ([]string, error) {
+	if group.Type != PlayerGroupDynamic {
+		return slices.Clone(group.Members), nil
+	}
+
+	rule, err := parse_group_rule(group.Rule)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, player := range players {
+		if rule.matches(player) {
+			names = append(names, player.Name)
+		}
+	}
+
+	return names, nil
+}
+
+// resolve_player_names expands group references in names, keeping the order and
+// dropping duplicates, must be called with connMutex held
+func resolve_player_names(names []string) []string {
+	resolved := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+
+	add := func(name string) {
+		if !seen[name] {
+			seen[name] = true
+			resolved = append(resolved, name)
+		}
+	}
+
+	for _, name := range names {
+		if !strings.HasPrefix(name, GroupReferencePrefix) {
+			add(name)
+			continue
+		}
+
+		groupName := strings.TrimPrefix(name, GroupReferencePrefix)
+		group := find_player_group(groupName)
+		if group == nil {
+			runtime.LogWarningf(app.ctx, "Unknown player group: %s", groupName)
+			continue
+		}
+
+		members, err := resolve_player_group(*group)
+		if err != nil {
+			runtime.LogErrorf(app.ctx, "Error resolving player group %s: %s", groupName, err.Error())
+			continue
+		}
+		runtime.LogDebugf(app.ctx, "Player group %s resolved to %v", groupName, members)
+
+		for _, member := range members {
+			add(member)
+		}
+	}
+
+	return resolved
+}
+
+func (app *App) GetPlayerGroups() []PlayerGroup {
+	return playerGroups
+}
+
+// SavePlayerGroup adds or replaces a group, returns an empty string on success or the error
+func (app *App) SavePlayerGroup(group PlayerGroup) string {
+	group.Name = strings.TrimSpace(group.Name)
+	if group.Name == "" {
+		return "group name is empty"
+	}
+
+	switch group.Type {
+	case PlayerGroupStatic:
+		group.Rule = ""
+		if group.Members == nil {
+			group.Members = []string{}
+		}
+	case PlayerGroupDynamic:
+		group.Members = []string{}
+		if _, err := parse_group_rule(group.Rule); err != nil {
+			return err.Error()
+		}
+	default:
+		return fmt.Sprintf("unknown group type: %s", group.Type)
+	}
+
+	if existing := find_player_group(group.Name); existing != nil {
+		*existing = group
+	} else {
+		playerGroups = append(playerGroups, group)
+	}
+
+	err := player_groups_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving groups: "+err.Error())
+		return err.Error()
+	}
+	runtime.EventsEmit(app.ctx, "update-player-groups", playerGroups)
+
+	return ""
+}
+
+func (app *App) DeletePlayerGroup(name string) bool {
+	index := slices.IndexFunc(playerGroups, func(group PlayerGroup) bool { return strings.EqualFold(group.Name, name) })
+	if index == -1 {
+		return false
+	}
+	playerGroups = slices.Delete(playerGroups, index, index+1)
+
+	err := player_groups_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving groups: "+err.Error())
+		return false
+	}
+	runtime.EventsEmit(app.ctx, "update-player-groups", playerGroups)
+
+	return true
+}
+
+// ResolvePlayerGroup returns the names the group currently resolves to
+func (app *App) ResolvePlayerGroup(name string) []string {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	return resolve_player_names([]string{GroupReferencePrefix + name})
+}
+
+// ValidateGroupRule returns an empty string if the rule is valid, otherwise the error
+func (app *App) ValidateGroupRule(rule string) string {
+	_, err := parse_group_rule(rule)
+	if err != nil {
+		return err.Error()
+	}
+
+	return ""
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"errors"
+	"os/user"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type PlayerNote struct {
+	Id      string `json:"id"`
+	Text    string `json:"text"`
+	Author  string `json:"author"`
+	Created int64  `json:"created"` // unix timestamp
+}
+
+type PlayerTag struct {
+	Name  string `json:"name"`
+	Color string `json:"color"` // CSS color, e.g. #22c55e
+}
+
+var playerTags []PlayerTag
+
+func get_default_player_tags() []PlayerTag {
+	return []PlayerTag{
+		{Name: "trusted", Color: "#22c55e"},
+		{Name: "watch", Color: "#ef4444"},
+		{Name: "donor", Color: "#eab308"},
+	}
+}
+
+func normalize_tag(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
+
+func player_tags_init() error {
+	playerTags = get_default_player_tags()
+
+	tagsFilePath := filepath.Join(get_server_folder(), "tags.json")
+	if !file_exists(tagsFilePath) {
+		return nil
+	}
+
+	var tags []PlayerTag
+	err := readJSON(tagsFilePath, &tags)
+	if err != nil {
+		return errors.New("Error reading tags file: " + err.Error())
+	}
+	playerTags = tags
+
+	return nil
+}
+
+func player_tags_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "tags.json"), playerTags)
+}
+
+// find_player returns the player with the given name, must be called with connMutex held
+func find_player(name string) *Player {
+	for i := range players {
+		if players[i].Name == name {
+			return &players[i]
+		}
+	}
+
+	return nil
+}
+
+// players_changed saves and emits the players after their notes, tags or fields changed,
+// must be called with connMutex held
+func players_changed() {
+	err := players_save()
+	if err != nil {
+		runtime.LogError(app.ctx, err.Error())
+	}
+	runtime.EventsEmit(app.ctx, "update-players", players)
+}
+
+func (app *App) AddPlayerNote(name string, text string, author string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	text = strings.TrimSpace(text)
+	player := find_player(name)
+	if player == nil || text == "" {
+		return false
+	}
+
+	author = strings.TrimSpace(author)
+	if author == "" {
+		if current, err := user.Current(); err == nil {
+			author = current.Username
+		}
+	}
+
+	now := time.Now()
+	player.Notes = append(player.Notes, PlayerNote{
+		Id:      strconv.FormatInt(now.UnixNano(), 36),
+		Text:    text,
+		Author:  author,
+		Created: now.Unix(),
+	})
+	runtime.LogDebugf(app.ctx, "Added note to player %s", name)
+	players_changed()
+
+	return true
+}
+
+func (app *App) DeletePlayerNote(name string, noteId string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	player := find_player(name)
+	if player == nil {
+		return false
+	}
+
+	for i, note := range player.Notes {
+		if note.Id == noteId {
+			player.Notes = slices.Delete(player.Notes, i, i+1)
+			players_changed()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (app *App) SetPlayerTags(name string, tags []string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	player := find_player(name)
+	if player == nil {
+		return false
+	}
+
+	player.Tags = []string{}
+	for _, tag := range tags {
+		tag = normalize_tag(tag)
+		if tag != "" && !slices.Contains(player.Tags, tag) {
+			player.Tags = append(player.Tags, tag)
+		}
+	}
+	players_changed()
+
+	return true
+}
+
+func (app *App) AddPlayerTag(name string, tag string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	tag = normalize_tag(tag)
+	player := find_player(name)
+	if player == nil || tag == "" {
+		return false
+	}
+
+	if !slices.Contains(player.Tags, tag) {
+		player.Tags = append(player.Tags, tag)
+		players_changed()
+	}
+
+	return true
+}
+
+func (app *App) RemovePlayerTag(name string, tag string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	tag = normalize_tag(tag)
+	player := find_player(name)
+	if player == nil {
+		return false
+	}
+
+	index := slices.Index(player.Tags, tag)
+	if index == -1 {
+		return false
+	}
+	player.Tags = slices.Delete(player.Tags, index, index+1)
+	players_changed()
+
+	return true
+}
+
+// SetPlayerCustomField sets a user-defined field of the player, an empty value removes it
+func (app *App) SetPlayerCustomField(name string, key string, value string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	key = strings.TrimSpace(key)
+	player := find_player(name)
+	if player == nil || key == "" {
+		return false
+	}
+
+	if value == "" {
+		delete(player.CustomFields, key)
+	} else {
+		if player.CustomFields == nil {
+			player.CustomFields = make(map[string]string)
+		}
+		player.CustomFields[key] = value
+	}
+	players_changed()
+
+	return true
+}
+
+// GetPlayersByTags returns the players that have any of the tags, or all of them if matchAll is set
+func (app *App) GetPlayersByTags(tags []string, matchAll bool) []Player {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	filtered := []Player{}
+	for _, player := range players {
+		matches := 0
+		for _, tag := range tags {
+			if slices.Contains(player.Tags, normalize_tag(tag)) {
+				matches++
+			}
+		}
+
+		if (matchAll && matches == len(tags)) || (!matchAll && matches > 0) {
+			filtered = append(filtered, player)
+		}
+	}
+
+	return filtered
+}
+
+func (app *App) GetPlayerTags() []PlayerTag {
+	return playerTags
+}
+
+// SavePlayerTag adds a tag definition or updates the color of an existing one
+func (app *App) SavePlayerTag(tag PlayerTag) bool {
+	tag.Name = normalize_tag(tag.Name)
+	if tag.Name == "" {
+		return false
+	}
+
+	found := false
+	for i := range playerTags {
+		if playerTags[i].Name == tag.Name {
+			playerTags[i].Color = tag.Color
+			found = true
+			break
+		}
+	}
+	if !found {
+		playerTags = append(playerTags, tag)
+	}
+
+	err := player_tags_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving tags: "+err.Error())
+		return false
+	}
+	runtime.EventsEmit(app.ctx, "update-player-tags", playerTags)
+
+	return true
+}
+
+// DeletePlayerTag removes the tag definition and the tag from all players
+func (app *App) DeletePlayerTag(name string) bool {
+	name = normalize_tag(name)
+
+	index := slices.IndexFunc(playerTags, func(tag PlayerTag) bool { return tag.Name == name })
+	if index == -1 {
+		return false
+	}
+	playerTags = slices.Delete(playerTags, index, index+1)
+
+	err := player_tags_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving tags: "+err.Error())
+		return false
+	}
+	runtime.EventsEmit(app.ctx, "update-player-tags", playerTags)
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	changed := false
+	for i := range players {
+		if index := slices.Index(players[i].Tags, name); index != -1 {
+			players[i].Tags = slices.Delete(players[i].Tags, index, index+1)
+			changed = true
+		}
+	}
+	if changed {
+		players_changed()
+	}
+
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -23,23 +24,33 @@ var (
 )
 
 type Credentials struct {
-	IP       string `json:"ip"`
-	Port     string `json:"port"`
-	Password string `json:"password"` // Encrypted
+	IP       string     `json:"ip"`
+	Port     string     `json:"port"`
+	Password string     `json:"password"` // Plain text, encrypted only at rest by the keyring, vault or fallback file
+	SSH      *SSHTunnel `json:"ssh,omitempty"`
 }
 
 type Player struct {
-	Name        string `json:"name"`
-	Online      bool   `json:"online"`
-	AccessLevel string `json:"accessLevel"`
-	Banned      bool   `json:"banned"`
-	Godmode     bool   `json:"godmode"`
+	Name         string            `json:"name"`
+	Online       bool              `json:"online"`
+	AccessLevel  string            `json:"accessLevel"`
+	Banned       bool              `json:"banned"`
+	Godmode      bool              `json:"godmode"`
+	Invisible    bool              `json:"invisible"`
+	Noclip       bool              `json:"noclip"`
+	VoiceBanned  bool              `json:"voiceBanned"`
+	LastTeleport *Coordinates      `json:"lastTeleport,omitempty"` // Last coordinates the player was teleported to
+	SteamId      string            `json:"steamId,omitempty"`
+	Notes        []PlayerNote      `json:"notes,omitempty"`
+	Tags         []string          `json:"tags,omitempty"`
+	CustomFields map[string]string `json:"customFields,omitempty"` // User-defined fields, e.g. discord name
 }
 
 type Coordinates struct {
-	X int `json:"x"`
-	Y int `json:"y"`
-	Z int `json:"z"`
+	X        int    `json:"x"`
+	Y        int    `json:"y"`
+	Z        int    `json:"z"`
+	Location string `json:"location,omitempty"` // Name of a saved location, replaces X, Y and Z
 }
 
 type ItemRecord struct {
@@ -63,7 +74,7 @@ func (app *App) ConnectRcon(credentials Credentials) bool {
 	if credentials.IP == "" || credentials.Port == "" || credentials.Password == "" {
 		return false
 	}
-	if conn != nil {
+	if conn != nil || isWatching {
 		app.DisconnectRcon()
 	}
 
@@ -71,7 +82,8 @@ func (app *App) ConnectRcon(credentials Credentials) bool {
 	defer connMutex.Unlock()
 
 	var err error
-	conn, err = rcon.Dial(credentials.IP+":"+credentials.Port, credentials.Password)
+	var tunnel *sshTunnelConn
+	conn, tunnel, err = dial_rcon(credentials)
 	if err != nil {
 		runtime.LogError(app.ctx, "Error connecting to RCON: "+err.Error())
 		app.SendNotification(Notification{
@@ -81,6 +93,7 @@ func (app *App) ConnectRcon(credentials Credentials) bool {
 		})
 		return false
 	}
+	set_ssh_tunnel(tunnel)
 
 	// Start the connection watcher
 	if !isWatching {
@@ -90,10 +103,43 @@ func (app *App) ConnectRcon(credentials Credentials) bool {
 	}
 
 	connectionCredentials = credentials
+	health_connected()
 	err = players_init()
 	if err != nil {
 		runtime.LogError(app.ctx, "Error initializing players: "+err.Error())
 	}
+	err = player_tags_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing tags: "+err.Error())
+	}
+	err = player_groups_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing groups: "+err.Error())
+	}
+	err = steam_bans_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing Steam ID bans: "+err.Error())
+	}
+	err = population_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing population: "+err.Error())
+	}
+	err = locations_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing locations: "+err.Error())
+	}
+	err = kit_grants_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing kit grants: "+err.Error())
+	}
+	err = starter_kit_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing starter kit: "+err.Error())
+	}
+	err = mod_catalog_init()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error initializing mod catalog: "+err.Error())
+	}
 	err = players_update()
 	if err != nil {
 		runtime.LogError(app.ctx, "Error updating players: "+err.Error())
@@ -102,6 +148,7 @@ func (app *App) ConnectRcon(credentials Credentials) bool {
 	if err != nil {
 		runtime.LogError(app.ctx, "Error updating pzOptions: "+err.Error())
 	}
+	starter_kit_check()
 
 	app.SendNotification(Notification{
 		Title:   "rcon.rcon_connection_established",
@@ -121,7 +168,8 @@ func (app *App) DisconnectRcon() bool {
 	connMutex.Lock()
 	defer connMutex.Unlock()
 
-	if conn == nil {
+	// The connection is nil while the SSH tunnel reconnects
+	if conn == nil && !isWatching {
 		return false
 	}
 
@@ -131,8 +179,14 @@ func (app *App) DisconnectRcon() bool {
 		isWatching = false
 	}
 
-	err := conn.Close()
+	var err error
+	if conn != nil {
+		err = conn.Close()
+	}
 	conn = nil
+	close_ssh_tunnel()
+	health_disconnected()
+	gather_reset()
 	return err == nil
 }
 
@@ -159,7 +213,7 @@ func (app *App) SendRconCommand(command string) RconResponse {
 		}
 	}
 
-	res, err := conn.Execute(command)
+	res, err := rcon_execute(command)
 
 	runtime.EventsEmit(app.ctx, "setProgress", 100)
 
@@ -171,6 +225,7 @@ func (app *App) SendRconCommand(command string) RconResponse {
 		}
 	}
 	if strings.Contains(command, "banuser ") && strings.Contains(res, "is now banned") {
+		metrics_record_success(command)
 		for i := range players {
 			if players[i].Name == strings.Split(command, " ")[1] {
 				players[i].Banned = true
@@ -179,6 +234,7 @@ func (app *App) SendRconCommand(command string) RconResponse {
 			}
 		}
 	} else if strings.Contains(command, "unbanuser ") && strings.Contains(res, "is now un-banned") {
+		metrics_record_success(command)
 		for i := range players {
 			if players[i].Name == strings.Split(command, " ")[1] {
 				players[i].Banned = false
@@ -186,7 +242,30 @@ func (app *App) SendRconCommand(command string) RconResponse {
 				break
 			}
 		}
+	} else if strings.Contains(command, "banid ") && strings.Contains(res, " is now banned") {
+		metrics_record_success(command)
+		steamId := strings.Trim(strings.Fields(command)[1], "\"")
+		add_steam_id_ban(SteamIdBan{SteamId: steamId, Created: time.Now().Unix()})
+		if player := player_by_steam_id(steamId); player != nil {
+			player.Banned = true
+			runtime.EventsEmit(app.ctx, "update-players", players)
+		}
+		if err := steam_bans_save(); err != nil {
+			runtime.LogError(app.ctx, "Error saving Steam ID bans: "+err.Error())
+		}
+	} else if strings.Contains(command, "unbanid ") && strings.Contains(res, " is now unbanned") {
+		metrics_record_success(command)
+		steamId := strings.Trim(strings.Fields(command)[1], "\"")
+		steamIdBans = slices.DeleteFunc(steamIdBans, func(ban SteamIdBan) bool { return ban.SteamId == steamId })
+		if player := player_by_steam_id(steamId); player != nil {
+			player.Banned = false
+			runtime.EventsEmit(app.ctx, "update-players", players)
+		}
+		if err := steam_bans_save(); err != nil {
+			runtime.LogError(app.ctx, "Error saving Steam ID bans: "+err.Error())
+		}
 	} else if strings.Contains(command, "kick ") && strings.Contains(res, " kicked.") {
+		metrics_record_success(command)
 		runtime.EventsEmit(app.ctx, "update-players", players)
 	} else if strings.Contains(command, "godmode ") || strings.Contains(command, "godmod ") {
 		if strings.Contains(res, " is now invincible.") {
@@ -206,6 +285,50 @@ func (app *App) SendRconCommand(command string) RconResponse {
 				}
 			}
 		}
+	} else if strings.Contains(command, "invisible ") {
+		if strings.Contains(res, " is now invisible.") {
+			for i := range players {
+				if players[i].Name == strings.Split(command, " ")[1] {
+					players[i].Invisible = true
+					runtime.EventsEmit(app.ctx, "update-players", players)
+					break
+				}
+			}
+		} else if strings.Contains(res, " is no more invisible.") {
+			for i := range players {
+				if players[i].Name == strings.Split(command, " ")[1] {
+					players[i].Invisible = false
+					runtime.EventsEmit(app.ctx, "update-players", players)
+					break
+				}
+			}
+		}
+	} else if strings.Contains(command, "noclip ") {
+		if strings.Contains(res, " won't collide.") {
+			for i := range players {
+				if players[i].Name == strings.Split(command, " ")[1] {
+					players[i].Noclip = true
+					runtime.EventsEmit(app.ctx, "update-players", players)
+					break
+				}
+			}
+		} else if strings.Contains(res, " will collide.") {
+			for i := range players {
+				if players[i].Name == strings.Split(command, " ")[1] {
+					players[i].Noclip = false
+					runtime.EventsEmit(app.ctx, "update-players", players)
+					break
+				}
+			}
+		}
+	} else if name, banned, ok := parse_voice_ban_command(command); ok && res == voice_ban_response(name, banned) {
+		for i := range players {
+			if players[i].Name == name {
+				players[i].VoiceBanned = banned
+				runtime.EventsEmit(app.ctx, "update-players", players)
+				break
+			}
+		}
 	} else if strings.Contains(command, "setaccesslevel ") {
 		if strings.Contains(res, " no longer has access level") {
 			for i := range players {
@@ -288,15 +411,21 @@ func (app *App) SendRconCommand(command string) RconResponse {
 
 // watchConnection monitors the RCON connection and logs if it is lost
 func (app *App) watchConnection() {
+	stop := stopWatching
+
 	for {
 		select {
-		case <-stopWatching:
+		case <-stop:
 			// Stop signal received, exit the goroutine
 			runtime.LogInfo(app.ctx, "Stopping RCON connection watcher")
 			err := players_save()
 			if err != nil {
 				runtime.LogError(app.ctx, "Error saving players: "+err.Error())
 			}
+			err = population_close()
+			if err != nil {
+				runtime.LogError(app.ctx, "Error saving population: "+err.Error())
+			}
 			players = nil
 			pzOptions = PzOptions{}
 			lastOptionsHash = ""
@@ -306,6 +435,10 @@ func (app *App) watchConnection() {
 			if conn == nil {
 				connMutex.Unlock()
 				runtime.LogInfo(app.ctx, "RCON connection lost")
+				health_disconnected()
+				if err := population_close(); err != nil {
+					runtime.LogError(app.ctx, "Error saving population: "+err.Error())
+				}
 				runtime.EventsEmit(app.ctx, "rconDisconnected", players)
 				isWatching = false
 				return
@@ -313,24 +446,70 @@ func (app *App) watchConnection() {
 
 			// Check if the connection is still valid by sending a ping command
 			err := players_update()
+			if err != nil && connectionCredentials.SSH != nil {
+				runtime.LogWarning(app.ctx, "RCON connection through SSH tunnel lost: "+err.Error())
+				err = reconnect_ssh_tunnel(stop)
+				if errors.Is(err, errSSHReconnectCancelled) {
+					connMutex.Unlock()
+					continue
+				}
+				if err == nil {
+					runtime.LogInfo(app.ctx, "SSH tunnel reconnected")
+					health_reconnected()
+					runtime.EventsEmit(app.ctx, "rconReconnected")
+					err = players_update()
+				}
+			}
 			if err != nil {
 				runtime.LogError(app.ctx, "Error updating players: "+err.Error())
 				runtime.LogError(app.ctx, "RCON connection lost: "+err.Error())
 				runtime.EventsEmit(app.ctx, "rconDisconnected", players)
-				conn.Close()
+				if conn != nil {
+					conn.Close()
+				}
 				conn = nil
+				close_ssh_tunnel()
+				health_disconnected()
+				if err := population_close(); err != nil {
+					runtime.LogError(app.ctx, "Error saving population: "+err.Error())
+				}
 				connMutex.Unlock()
 				isWatching = false
 				return
 			}
+			// Only checked here, other calls update the players without holding connMutex
+			starter_kit_check()
 			connMutex.Unlock()
+
+			health_emit()
 		}
 	}
 }
 
 func (app *App) SaveCredentials(credentials Credentials) bool {
-	var err error
-	credentials.Password, err = Encrypt(credentials.Password, "6f6c11c2-1dc8-417d-a68e-0e487629")
+	if vault_exists() {
+		err := vault_save_credentials(credentials)
+		if err != nil {
+			app.SendNotification(Notification{
+				Title:   "rcon.error_saving_credentials",
+				Message: err.Error(),
+				Variant: "error",
+			})
+			runtime.LogError(app.ctx, "Error saving credentials to vault: "+err.Error())
+			return false
+		}
+		return true
+	}
+
+	var previous StoredCredentials
+	if file_exists(credentialsPath) {
+		err := readJSON(credentialsPath, &previous)
+		if err != nil {
+			runtime.LogWarning(app.ctx, "Error reading previous credentials: "+err.Error())
+		}
+	}
+
+	stored, err := store_credentials(credentials)
 	if err != nil {
 		app.SendNotification(Notification{
 			Title:   "rcon.error_encrypting_credentials",
@@ -341,7 +520,7 @@ func (app *App) SaveCredentials(credentials Credentials) bool {
 		return false
 	}
 
-	err = writeJSON(credentialsPath, credentials)
+	err = writeJSON(credentialsPath, stored)
 	if err != nil {
 		app.SendNotification(Notification{
 			Title:   "rcon.error_saving_credentials",
@@ -352,17 +531,34 @@ func (app *App) SaveCredentials(credentials Credentials) bool {
 		return false
 	}
 
+	// Remove the keyring entry of the previously saved server
+	if previous.KeyringRef != "" && previous.KeyringRef != stored.KeyringRef {
+		err = forget_credentials(previous)
+		if err != nil {
+			runtime.LogWarning(app.ctx, "Error removing previous credentials from keyring: "+err.Error())
+		}
+	}
+
 	return true
 }
 
 func (app *App) LoadCredentials() Credentials {
+	if vault_exists() {
+		credentials, err := vault_last_used_credentials()
+		if err != nil {
+			runtime.LogInfo(app.ctx, "Not loading credentials: "+err.Error())
+			return Credentials{}
+		}
+		return credentials
+	}
+
 	if !file_exists(credentialsPath) {
 		runtime.LogInfof(app.ctx, "Credentials file not found: %s", credentialsPath)
 		return Credentials{}
 	}
 
-	var credentials Credentials
-	err := readJSON(credentialsPath, &credentials)
+	var stored StoredCredentials
+	err := readJSON(credentialsPath, &stored)
 	if err != nil {
 		app.SendNotification(Notification{
 			Title:   "rcon.error_loading_credentials",
@@ -373,7 +569,14 @@ func (app *App) LoadCredentials() Credentials {
 		return Credentials{}
 	}
 
-	credentials.Password, err = Decrypt(credentials.Password, "6f6c11c2-1dc8-417d-a68e-0e487629")
+	// Migrate credentials saved with the built-in key or without authenticated encryption
+	legacy := credentials_legacy(stored)
+	var credentials Credentials
+	if legacy {
+		credentials, err = load_legacy_credentials(stored)
+	} else {
+		credentials, err = load_credentials(stored)
+	}
 	if err != nil {
 		app.SendNotification(Notification{
 			Title:   "rcon.error_decrypting_credentials",
@@ -384,11 +587,42 @@ func (app *App) LoadCredentials() Credentials {
 		return Credentials{}
 	}
 
+	if legacy {
+		runtime.LogInfo(app.ctx, "Migrating saved credentials")
+		if app.SaveCredentials(credentials) {
+			runtime.LogInfo(app.ctx, "Migrating saved credentials complete")
+		}
+	}
+
 	return credentials
 }
 
-func (app *App) DeleteCredentials() bool {
-	err := os.Remove(credentialsPath)
+// DeleteCredentials removes the saved credentials of the server, only one server is
+// saved without the vault so the server is only used to find the vault entry
+func (app *App) DeleteCredentials(credentials Credentials) bool {
+	if vault_exists() {
+		err := vault_delete_credentials(credentials)
+		if err != nil {
+			runtime.LogError(app.ctx, "Error deleting credentials from vault: "+err.Error())
+			return false
+		}
+		return true
+	}
+
+	var stored StoredCredentials
+	if file_exists(credentialsPath) {
+		err := readJSON(credentialsPath, &stored)
+		if err != nil {
+			runtime.LogWarning(app.ctx, "Error reading credentials: "+err.Error())
+		}
+	}
+
+	err := forget_credentials(stored)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error deleting credentials from keyring: "+err.Error())
+	}
+
+	err = os.Remove(credentialsPath)
 	if err != nil {
 		runtime.LogError(app.ctx, "Error deleting credentials: "+err.Error())
 		return false
@@ -397,12 +631,27 @@ func (app *App) DeleteCredentials() bool {
 	return true
 }
 
+// get_server_id identifies the connected server, e.g. 127.0.0.1-16261
+func get_server_id() string {
+	serverId := connectionCredentials.IP + "-" + connectionCredentials.Port
+	if connectionCredentials.SSH != nil {
+		serverId = connectionCredentials.SSH.Host + "-" + serverId
+	}
+
+	return serverId
+}
+
+// get_server_folder returns the folder that keeps the data of the connected server
+func get_server_folder() string {
+	return filepath.Join(appFolder, get_server_id())
+}
+
 func players_init() error {
 	players = []Player{}
 
-	playersFilePath := filepath.Join(appFolder, connectionCredentials.IP+"-"+connectionCredentials.Port, "players.json")
+	playersFilePath := filepath.Join(get_server_folder(), "players.json")
 	if !file_exists(playersFilePath) {
-		err := create_folder(filepath.Join(appFolder, connectionCredentials.IP+"-"+connectionCredentials.Port))
+		err := create_folder(get_server_folder())
 		if err != nil {
 			return errors.New("Error creating server folder: " + err.Error())
 		}
@@ -429,7 +678,7 @@ func players_init() error {
 }
 
 func players_update() error {
-	res, err := conn.Execute("players")
+	res, err := rcon_execute("players")
 	if err != nil {
 		return errors.New("Error getting players: " + err.Error())
 	}
@@ -451,6 +700,8 @@ func players_update() error {
 			onlinePlayers[playerName[1:]] = true
 		}
 	}
+	metrics_set_players_online(len(onlinePlayers))
+	population_record(onlinePlayers)
 
 	playerMap := make(map[string]*Player, len(players))
 	for i := range players {
@@ -507,7 +758,7 @@ func players_update() error {
 }
 
 func players_save() error {
-	err := writeJSON(filepath.Join(appFolder, connectionCredentials.IP+"-"+connectionCredentials.Port, "players.json"), players)
+	err := writeJSON(filepath.Join(get_server_folder(), "players.json"), players)
 	if err != nil {
 		return errors.New("Error saving players: " + err.Error())
 	}
@@ -670,6 +921,40 @@ type RCONCommandNotifications struct {
 	SingleFail    string // Notification for single name failure
 }
 
+// send_bulk_notifications reports the outcome of an action on total players, for actions
+// that don't run through RCONCommand
+func (app *App) send_bulk_notifications(prefix string, successCount int, total int, lastErr string) {
+	switch successCount {
+	case total:
+		app.SendNotification(Notification{
+			Title:   prefix + ".all_success",
+			Variant: "success",
+			Parameters: map[string]string{
+				"s": fmt.Sprintf("%d", successCount),
+			},
+		})
+	case 0:
+		app.SendNotification(Notification{
+			Title:   prefix + ".all_fail",
+			Message: lastErr,
+			Variant: "error",
+			Parameters: map[string]string{
+				"f": fmt.Sprintf("%d", total),
+			},
+		})
+	default:
+		app.SendNotification(Notification{
+			Title:   prefix + ".partial",
+			Message: lastErr,
+			Variant: "warning",
+			Parameters: map[string]string{
+				"s": fmt.Sprintf("%d", successCount),
+				"f": fmt.Sprintf("%d", total-successCount),
+			},
+		})
+	}
+}
+
 type RCONCommand struct {
 	CommandTemplate   string                      // Command template with placeholders
 	PlayerNames       []string                    // List of player names
@@ -689,7 +974,16 @@ func (params *RCONCommand) execute() int {
 	defer runtime.EventsEmit(app.ctx, "setProgress", 0)
 	runtime.EventsEmit(app.ctx, "setProgress", 10)
 
-	names := params.PlayerNames
+	// Group references are resolved when the command runs so dynamic groups are current
+	names := resolve_player_names(params.PlayerNames)
+	if len(params.PlayerNames) > 0 && len(names) == 0 {
+		runtime.LogWarning(app.ctx, "No players to run the command on")
+		app.SendNotification(Notification{
+			Title:   "rcon.groups.no_players",
+			Variant: "warning",
+		})
+		return 0
+	}
 	successCount := 0
 	total := 1 // Default to 1 for commands without names
 	if len(names) > 0 {
@@ -742,7 +1036,7 @@ func (params *RCONCommand) execute() int {
 			continue
 		}
 
-		res, err = conn.Execute(command)
+		res, err = rcon_execute(command)
 
 		if err != nil {
 			lastErrRes = res
@@ -768,6 +1062,7 @@ func (params *RCONCommand) execute() int {
 		if names == nil {
 			if params.SuccessCheck != nil && params.SuccessCheck("", res) {
 				successCount++
+				metrics_record_success(command)
 				if params.UpdateFunc != nil {
 					params.UpdateFunc("", res)
 				}
@@ -777,6 +1072,7 @@ func (params *RCONCommand) execute() int {
 		} else {
 			if params.SuccessCheck != nil && params.SuccessCheck(names[i], res) {
 				successCount++
+				metrics_record_success(command)
 				if params.UpdateFunc != nil {
 					if len(names) > 0 {
 						params.UpdateFunc(names[i], res)
@@ -1051,7 +1347,190 @@ func (app *App) GodMode(names []string, value bool) {
 	command.execute()
 }
 
+func (app *App) Invisible(names []string, value bool) {
+	defer players_update()
+
+	playerMap := make(map[string]*Player, len(players))
+	for i := range players {
+		playerMap[players[i].Name] = &players[i]
+	}
+
+	command := RCONCommand{
+		CommandTemplate: "invisible {name} {value}",
+		PlayerNames:     names,
+		Args: []RCONCommandParam{
+			{
+				Name: "value",
+				Value: func() interface{} {
+					if value {
+						return "-true"
+					}
+					return "-false"
+
+				}(),
+				Mandatory: true,
+			},
+		},
+		SuccessCheck: func(name string, response string) bool {
+			return strings.Contains(response, " is now invisible.") || strings.Contains(response, " is no more invisible.")
+		},
+		ErrorCheck: func(name string, response string) bool {
+			return response == fmt.Sprintf("User %s not found.", name)
+		},
+		UpdateFunc: func(name string, response string) {
+			player, ok := playerMap[name]
+			if ok {
+				player.Invisible = value
+			}
+		},
+		EmitUpdatePlayers: true,
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.invisible.all_success",
+			AllFail:       "rcon.invisible.all_fail",
+			Partial:       "rcon.invisible.partial",
+			SingleSuccess: "rcon.invisible.single_success",
+			SingleFail:    "rcon.invisible.single_fail",
+		},
+	}
+
+	command.execute()
+}
+
+func (app *App) Noclip(names []string, value bool) {
+	defer players_update()
+
+	playerMap := make(map[string]*Player, len(players))
+	for i := range players {
+		playerMap[players[i].Name] = &players[i]
+	}
+
+	command := RCONCommand{
+		CommandTemplate: "noclip {name} {value}",
+		PlayerNames:     names,
+		Args: []RCONCommandParam{
+			{
+				Name: "value",
+				Value: func() interface{} {
+					if value {
+						return "-true"
+					}
+					return "-false"
+
+				}(),
+				Mandatory: true,
+			},
+		},
+		SuccessCheck: func(name string, response string) bool {
+			return strings.Contains(response, " won't collide.") || strings.Contains(response, " will collide.")
+		},
+		ErrorCheck: func(name string, response string) bool {
+			return response == fmt.Sprintf("User %s not found.", name)
+		},
+		UpdateFunc: func(name string, response string) {
+			player, ok := playerMap[name]
+			if ok {
+				player.Noclip = value
+			}
+		},
+		EmitUpdatePlayers: true,
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.noclip.all_success",
+			AllFail:       "rcon.noclip.all_fail",
+			Partial:       "rcon.noclip.partial",
+			SingleSuccess: "rcon.noclip.single_success",
+			SingleFail:    "rcon.noclip.single_fail",
+		},
+	}
+
+	command.execute()
+}
+
+// voice_ban_response is the server's answer to a successful voiceban
+func voice_ban_response(name string, banned bool) string {
+	if banned {
+		return fmt.Sprintf("User %s voice is banned.", name)
+	}
+	return fmt.Sprintf("User %s voice is unbanned.", name)
+}
+
+// parse_voice_ban_command returns the player and the value of a voiceban command, the
+// server only answers with the help text when -true or -false is missing
+func parse_voice_ban_command(command string) (string, bool, bool) {
+	fields := strings.Fields(command)
+	if len(fields) < 3 || fields[0] != "voiceban" {
+		return "", false, false
+	}
+
+	var banned bool
+	switch fields[len(fields)-1] {
+	case "-true":
+		banned = true
+	case "-false":
+		banned = false
+	default:
+		return "", false, false
+	}
+
+	name := strings.Trim(strings.Join(fields[1:len(fields)-1], " "), "\"")
+	return name, banned, name != ""
+}
+
+func (app *App) VoiceBan(names []string, enabled bool) {
+	playerMap := make(map[string]*Player, len(players))
+	for i := range players {
+		playerMap[players[i].Name] = &players[i]
+	}
+
+	command := RCONCommand{
+		CommandTemplate: "voiceban {name} {value}",
+		PlayerNames:     names,
+		Args: []RCONCommandParam{
+			{
+				Name: "value",
+				Value: func() interface{} {
+					if enabled {
+						return "-true"
+					}
+					return "-false"
+				}(),
+				Mandatory: true,
+			},
+		},
+		SuccessCheck: func(name string, response string) bool {
+			return response == voice_ban_response(name, enabled)
+		},
+		ErrorCheck: func(name string, response string) bool {
+			return response == fmt.Sprintf("User %s not found.", name) || strings.HasPrefix(response, "Ban a user from using the voice")
+		},
+		UpdateFunc: func(name string, response string) {
+			player, ok := playerMap[name]
+			if ok {
+				player.VoiceBanned = enabled
+			}
+		},
+		EmitUpdatePlayers: true,
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.voiceBan.all_success",
+			AllFail:       "rcon.voiceBan.all_fail",
+			Partial:       "rcon.voiceBan.partial",
+			SingleSuccess: "rcon.voiceBan.single_success",
+			SingleFail:    "rcon.voiceBan.single_fail",
+		},
+	}
+
+	if command.execute() > 0 {
+		err := players_save()
+		if err != nil {
+			runtime.LogError(app.ctx, err.Error())
+		}
+	}
+}
+
 func (app *App) TeleportToCoordinates(names []string, coordinates Coordinates) {
+	if !resolve_coordinates(&coordinates) {
+		return
+	}
+
 	command := RCONCommand{
 		CommandTemplate: "teleportto {name} {coordinates}",
 		PlayerNames:     names,
@@ -1070,6 +1549,11 @@ func (app *App) TeleportToCoordinates(names []string, coordinates Coordinates) {
 		ErrorCheck: func(name string, response string) bool {
 			return response == fmt.Sprintf("Can't find player %s", name)
 		},
+		UpdateFunc: func(name string, response string) {
+			if player := find_player(name); player != nil {
+				player.LastTeleport = &coordinates
+			}
+		},
 		Notifications: RCONCommandNotifications{
 			AllSuccess:    "rcon.teleport.all_success",
 			AllFail:       "rcon.teleport.all_fail",
@@ -1079,7 +1563,12 @@ func (app *App) TeleportToCoordinates(names []string, coordinates Coordinates) {
 		},
 	}
 
-	command.execute()
+	if command.execute() > 0 {
+		err := players_save()
+		if err != nil {
+			runtime.LogError(app.ctx, err.Error())
+		}
+	}
 }
 
 func (app *App) TeleportToUser(names []string, targetUser string) {
@@ -1160,6 +1649,82 @@ func (app *App) SetAccessLevel(names []string, accessLevel string) {
 	command.execute()
 }
 
+type RemoveZombiesOptions struct {
+	Coordinates *Coordinates `json:"coordinates"` // Center when no players are given
+	Radius      int          `json:"radius"`
+	Reanimated  bool         `json:"reanimated"` // Also remove reanimated player corpses
+}
+
+// RemoveZombies removes the zombies in a radius around each player, or around the
+// coordinates of the options when no players are given
+func (app *App) RemoveZombies(names []string, options RemoveZombiesOptions) {
+	commandTemplate := "removezombies -player {name} {radius} {reanimated}"
+	var coordinates interface{}
+	if len(names) == 0 {
+		if options.Coordinates == nil {
+			runtime.LogError(app.ctx, "RemoveZombies needs players or coordinates")
+			return
+		}
+		if !resolve_coordinates(options.Coordinates) {
+			return
+		}
+		commandTemplate = "removezombies {coordinates} {radius} {reanimated}"
+		coordinates = fmt.Sprintf("-x %d -y %d -z %d", options.Coordinates.X, options.Coordinates.Y, options.Coordinates.Z)
+	}
+
+	command := RCONCommand{
+		CommandTemplate: commandTemplate,
+		PlayerNames:     names,
+		Args: []RCONCommandParam{
+			{
+				Name:  "coordinates",
+				Value: coordinates,
+			},
+			{
+				Name: "radius",
+				Key:  "-radius",
+				Value: func() interface{} {
+					if options.Radius <= 0 {
+						return nil
+					}
+					return options.Radius
+				}(),
+			},
+			{
+				Name: "reanimated",
+				Key:  "-reanimated",
+				Value: func() interface{} {
+					if !options.Reanimated {
+						return nil
+					}
+					return "true"
+				}(),
+			},
+		},
+		SuccessCheck: func(name string, response string) bool {
+			return response == "Zombies removed."
+		},
+		ErrorCheck: func(name string, response string) bool {
+			return response == fmt.Sprintf("User %s not found.", name)
+		},
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.removeZombies.all_success",
+			AllFail:       "rcon.removeZombies.all_fail",
+			Partial:       "rcon.removeZombies.partial",
+			SingleSuccess: "rcon.removeZombies.single_success",
+			SingleFail:    "rcon.removeZombies.single_fail",
+		},
+	}
+
+	// Commands without names only use the single notifications, which mention a player
+	if len(names) == 0 {
+		command.Notifications.SingleSuccess = "rcon.removeZombies.coordinates_success"
+		command.Notifications.SingleFail = "rcon.removeZombies.coordinates_fail"
+	}
+
+	command.execute()
+}
+
 func (app *App) CreateHorde(names []string, count int) {
 	command := RCONCommand{
 		CommandTemplate: "createhorde {count} {name}",
@@ -1229,31 +1794,36 @@ func (app *App) Thunder(names []string) {
 	command.execute()
 }
 
+// add_xp adds xp to one perk of the players and returns the number of players it was added to
+func add_xp(names []string, perk string, amount int) int {
+	command := RCONCommand{
+		CommandTemplate: "addxp {name} {perk}",
+		PlayerNames:     names,
+		Args: []RCONCommandParam{
+			{
+				Name: "perk",
+				Value: func() interface{} {
+					return fmt.Sprintf("%s=%d", perk, amount)
+				}(),
+				Mandatory: true,
+			},
+		},
+		SuccessCheck: func(name string, response string) bool {
+			return response == fmt.Sprintf("Added %d %s xp's to %s", amount, perk, name)
+		},
+		ErrorCheck: func(_ string, response string) bool {
+			return response == "No such user"
+		},
+	}
+
+	return command.execute()
+}
+
 func (app *App) AddXp(names []string, perks []string, amount int) {
 	successCount := 0
 
 	for _, perk := range perks {
-		command := RCONCommand{
-			CommandTemplate: "addxp {name} {perk}",
-			PlayerNames:     names,
-			Args: []RCONCommandParam{
-				{
-					Name: "perk",
-					Value: func() interface{} {
-						return fmt.Sprintf("%s=%d", perk, amount)
-					}(),
-					Mandatory: true,
-				},
-			},
-			SuccessCheck: func(name string, response string) bool {
-				return response == fmt.Sprintf("Added %d %s xp's to %s", amount, perk, name)
-			},
-			ErrorCheck: func(_ string, response string) bool {
-				return response == "No such user"
-			},
-		}
-
-		successCount += command.execute()
+		successCount += add_xp(names, perk, amount)
 	}
 
 	if successCount > 0 {
@@ -1272,6 +1842,31 @@ func (app *App) AddXp(names []string, perks []string, amount int) {
 }
 
 func (app *App) AddVehicle(vehicleId string, names []string, coordinates Coordinates) {
+	if len(names) == 0 && !resolve_coordinates(&coordinates) {
+		return
+	}
+
+	check := check_vehicle_id(vehicleId)
+	switch check.Status {
+	case CatalogIdUnknown:
+		runtime.LogWarningf(app.ctx, "Not adding unknown vehicle %s, closest matches: %s", check.Id, strings.Join(check.Suggestions, ", "))
+		app.send_unknown_id_notification("rcon.addVehicle.unknown_vehicle", "error", check)
+		return
+	case CatalogIdUnlisted:
+		runtime.LogWarningf(app.ctx, "Vehicle %s isn't in the catalog, adding it anyway", check.Id)
+		app.send_unknown_id_notification("rcon.addVehicle.unlisted_vehicle", "warning", check)
+	case CatalogIdUnknownModule:
+		runtime.LogWarningf(app.ctx, "Vehicle %s is from an unknown module", check.Id)
+		app.SendNotification(Notification{
+			Title:   "rcon.addVehicle.unknown_module",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"module": check.Module,
+			},
+		})
+	}
+	vehicleId = check.Id
+
 	command := RCONCommand{
 		CommandTemplate: "addvehicle {vehicleId} {name}",
 		PlayerNames:     names,
@@ -1307,6 +1902,11 @@ func (app *App) AddVehicle(vehicleId string, names []string, coordinates Coordin
 }
 
 func (app *App) AddItems(names []string, itemRecords []ItemRecord) {
+	itemRecords = app.validate_item_records(itemRecords)
+	if len(itemRecords) == 0 {
+		return
+	}
+
 	successCount := 0
 
 	for _, itemRecord := range itemRecords {
@@ -1375,7 +1975,16 @@ func (app *App) StopServer() bool {
 		},
 	}
 
-	return command.execute() == 1
+	if command.execute() != 1 {
+		return false
+	}
+
+	// Players are kicked when the server stops
+	connMutex.Lock()
+	gather_reset()
+	connMutex.Unlock()
+
+	return true
 }
 
 func (app *App) CheckModsNeedUpdate() {
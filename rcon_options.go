@@ -1,196 +1,251 @@
 package main
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 )
 
-type PzOptions struct {
-	AdminSafehouse                               bool    `json:"AdminSafehouse"`
-	AllowCoop                                    bool    `json:"AllowCoop"`
-	AllowDestructionBySledgehammer               bool    `json:"AllowDestructionBySledgehammer"`
-	AllowNonAsciiUsername                        bool    `json:"AllowNonAsciiUsername"`
-	AnnounceDeath                                bool    `json:"AnnounceDeath"`
-	AntiCheatProtectionType1                     bool    `json:"AntiCheatProtectionType1"`
-	AntiCheatProtectionType2                     bool    `json:"AntiCheatProtectionType2"`
-	AntiCheatProtectionType3                     bool    `json:"AntiCheatProtectionType3"`
-	AntiCheatProtectionType4                     bool    `json:"AntiCheatProtectionType4"`
-	AntiCheatProtectionType5                     bool    `json:"AntiCheatProtectionType5"`
-	AntiCheatProtectionType6                     bool    `json:"AntiCheatProtectionType6"`
-	AntiCheatProtectionType7                     bool    `json:"AntiCheatProtectionType7"`
-	AntiCheatProtectionType8                     bool    `json:"AntiCheatProtectionType8"`
-	AntiCheatProtectionType9                     bool    `json:"AntiCheatProtectionType9"`
-	AntiCheatProtectionType10                    bool    `json:"AntiCheatProtectionType10"`
-	AntiCheatProtectionType11                    bool    `json:"AntiCheatProtectionType11"`
-	AntiCheatProtectionType12                    bool    `json:"AntiCheatProtectionType12"`
-	AntiCheatProtectionType13                    bool    `json:"AntiCheatProtectionType13"`
-	AntiCheatProtectionType14                    bool    `json:"AntiCheatProtectionType14"`
-	AntiCheatProtectionType15                    bool    `json:"AntiCheatProtectionType15"`
-	AntiCheatProtectionType16                    bool    `json:"AntiCheatProtectionType16"`
-	AntiCheatProtectionType17                    bool    `json:"AntiCheatProtectionType17"`
-	AntiCheatProtectionType18                    bool    `json:"AntiCheatProtectionType18"`
-	AntiCheatProtectionType19                    bool    `json:"AntiCheatProtectionType19"`
-	AntiCheatProtectionType20                    bool    `json:"AntiCheatProtectionType20"`
-	AntiCheatProtectionType21                    bool    `json:"AntiCheatProtectionType21"`
-	AntiCheatProtectionType22                    bool    `json:"AntiCheatProtectionType22"`
-	AntiCheatProtectionType23                    bool    `json:"AntiCheatProtectionType23"`
-	AntiCheatProtectionType24                    bool    `json:"AntiCheatProtectionType24"`
-	AntiCheatProtectionType2ThresholdMultiplier  float64 `json:"AntiCheatProtectionType2ThresholdMultiplier"`
-	AntiCheatProtectionType3ThresholdMultiplier  float64 `json:"AntiCheatProtectionType3ThresholdMultiplier"`
-	AntiCheatProtectionType4ThresholdMultiplier  float64 `json:"AntiCheatProtectionType4ThresholdMultiplier"`
-	AntiCheatProtectionType9ThresholdMultiplier  float64 `json:"AntiCheatProtectionType9ThresholdMultiplier"`
-	AntiCheatProtectionType15ThresholdMultiplier float64 `json:"AntiCheatProtectionType15ThresholdMultiplier"`
-	AntiCheatProtectionType20ThresholdMultiplier float64 `json:"AntiCheatProtectionType20ThresholdMultiplier"`
-	AntiCheatProtectionType22ThresholdMultiplier float64 `json:"AntiCheatProtectionType22ThresholdMultiplier"`
-	AntiCheatProtectionType24ThresholdMultiplier float64 `json:"AntiCheatProtectionType24ThresholdMultiplier"`
-	AutoCreateUserInWhiteList                    bool    `json:"AutoCreateUserInWhiteList"`
-	BackupsCount                                 int     `json:"BackupsCount"`
-	BackupsOnStart                               bool    `json:"BackupsOnStart"`
-	BackupsOnVersionChange                       bool    `json:"BackupsOnVersionChange"`
-	BackupsPeriod                                int     `json:"BackupsPeriod"`
-	BanKickGlobalSound                           bool    `json:"BanKickGlobalSound"`
-	BloodSplatLifespanDays                       int     `json:"BloodSplatLifespanDays"`
-	CarEngineAttractionModifier                  float64 `json:"CarEngineAttractionModifier"`
-	ChatStreams                                  string  `json:"ChatStreams"`
-	ClientActionLogs                             string  `json:"ClientActionLogs"`
-	ClientCommandFilter                          string  `json:"ClientCommandFilter"`
-	ConstructionPreventsLootRespawn              bool    `json:"ConstructionPreventsLootRespawn"`
-	DefaultPort                                  int     `json:"DefaultPort"`
-	DenyLoginOnOverloadedServer                  bool    `json:"DenyLoginOnOverloadedServer"`
-	DisableRadioAdmin                            bool    `json:"DisableRadioAdmin"`
-	DisableRadioGM                               bool    `json:"DisableRadioGM"`
-	DisableRadioInvisible                        bool    `json:"DisableRadioInvisible"`
-	DisableRadioModerator                        bool    `json:"DisableRadioModerator"`
-	DisableRadioOverseer                         bool    `json:"DisableRadioOverseer"`
-	DisableRadioStaff                            bool    `json:"DisableRadioStaff"`
-	DisableSafehouseWhenPlayerConnected          bool    `json:"DisableSafehouseWhenPlayerConnected"`
-	DiscordEnable                                bool    `json:"DiscordEnable"`
-	DiscordToken                                 string  `json:"DiscordToken"`
-	DiscordChannel                               string  `json:"DiscordChannel"`
-	DiscordChannelID                             string  `json:"DiscordChannelID"`
-	DisplayUserName                              bool    `json:"DisplayUserName"`
-	DoLuaChecksum                                bool    `json:"DoLuaChecksum"`
-	DropOffWhiteListAfterDeath                   bool    `json:"DropOffWhiteListAfterDeath"`
-	Faction                                      bool    `json:"Faction"`
-	FactionDaySurvivedToCreate                   int     `json:"FactionDaySurvivedToCreate"`
-	FactionPlayersRequiredForTag                 int     `json:"FactionPlayersRequiredForTag"`
-	FastForwardMultiplier                        float64 `json:"FastForwardMultiplier"`
-	GlobalChat                                   bool    `json:"GlobalChat"`
-	HidePlayersBehindYou                         bool    `json:"HidePlayersBehindYou"`
-	HoursForLootRespawn                          int     `json:"HoursForLootRespawn"`
-	ItemNumbersLimitPerContainer                 int     `json:"ItemNumbersLimitPerContainer"`
-	KickFastPlayers                              bool    `json:"KickFastPlayers"`
-	KnockedDownAllowed                           bool    `json:"KnockedDownAllowed"`
-	LoginQueueConnectTimeout                     int     `json:"LoginQueueConnectTimeout"`
-	LoginQueueEnabled                            bool    `json:"LoginQueueEnabled"`
-	Map                                          string  `json:"Map"`
-	MapRemotePlayerVisibility                    int     `json:"MapRemotePlayerVisibility"`
-	MaxAccountsPerUser                           int     `json:"MaxAccountsPerUser"`
-	MaxItemsForLootRespawn                       int     `json:"MaxItemsForLootRespawn"`
-	MaxPlayers                                   int     `json:"MaxPlayers"`
-	MinutesPerPage                               float64 `json:"MinutesPerPage"`
-	Mods                                         string  `json:"Mods"`
-	MouseOverToSeeDisplayName                    bool    `json:"MouseOverToSeeDisplayName"`
-	NoFire                                       bool    `json:"NoFire"`
-	Open                                         bool    `json:"Open"`
-	PVP                                          bool    `json:"PVP"`
-	PVPFirearmDamageModifier                     float64 `json:"PVPFirearmDamageModifier"`
-	PVPMeleeDamageModifier                       float64 `json:"PVPMeleeDamageModifier"`
-	PVPMeleeWhileHitReaction                     bool    `json:"PVPMeleeWhileHitReaction"`
-	PauseEmpty                                   bool    `json:"PauseEmpty"`
-	PerkLogs                                     bool    `json:"PerkLogs"`
-	PingLimit                                    int     `json:"PingLimit"`
-	PlayerBumpPlayer                             bool    `json:"PlayerBumpPlayer"`
-	PlayerRespawnWithOther                       bool    `json:"PlayerRespawnWithOther"`
-	PlayerRespawnWithSelf                        bool    `json:"PlayerRespawnWithSelf"`
-	PlayerSafehouse                              bool    `json:"PlayerSafehouse"`
-	Public                                       bool    `json:"Public"`
-	PublicDescription                            string  `json:"PublicDescription"`
-	PublicName                                   string  `json:"PublicName"`
-	RemovePlayerCorpsesOnCorpseRemoval           bool    `json:"RemovePlayerCorpsesOnCorpseRemoval"`
-	ResetID                                      int     `json:"ResetID"`
-	SafeHouseRemovalTime                         int     `json:"SafeHouseRemovalTime"`
-	SafehouseAllowFire                           bool    `json:"SafehouseAllowFire"`
-	SafehouseAllowLoot                           bool    `json:"SafehouseAllowLoot"`
-	SafehouseAllowNonResidential                 bool    `json:"SafehouseAllowNonResidential"`
-	SafehouseAllowRespawn                        bool    `json:"SafehouseAllowRespawn"`
-	SafehouseAllowTrepass                        bool    `json:"SafehouseAllowTrepass"`
-	SafehouseDaySurvivedToClaim                  int     `json:"SafehouseDaySurvivedToClaim"`
-	SafetyCooldownTimer                          int     `json:"SafetyCooldownTimer"`
-	SafetySystem                                 bool    `json:"SafetySystem"`
-	SafetyToggleTimer                            int     `json:"SafetyToggleTimer"`
-	SaveWorldEveryMinutes                        int     `json:"SaveWorldEveryMinutes"`
-	ServerPlayerID                               int     `json:"ServerPlayerID"`
-	ShowFirstAndLastName                         bool    `json:"ShowFirstAndLastName"`
-	ShowSafety                                   bool    `json:"ShowSafety"`
-	SledgehammerOnlyInSafehouse                  bool    `json:"SledgehammerOnlyInSafehouse"`
-	SleepAllowed                                 bool    `json:"SleepAllowed"`
-	SleepNeeded                                  bool    `json:"SleepNeeded"`
-	SneakModeHideFromOtherPlayers                bool    `json:"SneakModeHideFromOtherPlayers"`
-	SpawnItems                                   string  `json:"SpawnItems"`
-	SpawnPoint                                   string  `json:"SpawnPoint"`
-	SpeedLimit                                   float64 `json:"SpeedLimit"`
-	SteamScoreboard                              bool    `json:"SteamScoreboard"`
-	SteamVAC                                     bool    `json:"SteamVAC"`
-	TrashDeleteAll                               bool    `json:"TrashDeleteAll"`
-	UDPPort                                      int     `json:"UDPPort"`
-	UPnP                                         bool    `json:"UPnP"`
-	Voice3D                                      bool    `json:"Voice3D"`
-	VoiceEnable                                  bool    `json:"VoiceEnable"`
-	VoiceMaxDistance                             float64 `json:"VoiceMaxDistance"`
-	VoiceMinDistance                             float64 `json:"VoiceMinDistance"`
-	WorkshopItems                                string  `json:"WorkshopItems"`
-	ServerWelcomeMessage                         string  `json:"ServerWelcomeMessage"`
+const (
+	OptionTypeBool   = "bool"
+	OptionTypeInt    = "int"
+	OptionTypeFloat  = "float"
+	OptionTypeString = "string"
+)
+
+type PzOptionSchema struct {
+	Name string
+	Type string
 }
 
-type OptionPair struct {
+type PzOption struct {
 	Name  string
-	Value string
+	Type  string
+	Value any // bool, int, float64 or string by Type
 }
 
-var (
-	pzOptions       PzOptions
-	lastOptionsHash string
-)
+// PzOptions holds the server options in the order of showoptions, it is encoded to JSON
+// as an object of option names to values so unknown options are kept
+type PzOptions struct {
+	options []PzOption
+	index   map[string]int
+}
+
+var pzOptionTypes = func() map[string]string {
+	types := map[string]string{}
+	for _, schema := range pzOptionsSchema {
+		types[schema.Name] = schema.Type
+	}
+	return types
+}()
 
-func setFieldValue(field reflect.Value, value string) error {
-	switch field.Kind() {
-	case reflect.Bool:
-		field.SetBool(strings.ToLower(value) == "true")
-	case reflect.Int:
-		intVal, err := strconv.Atoi(value)
+func (o *PzOptions) Set(name string, optionType string, value any) {
+	if o.index == nil {
+		o.index = map[string]int{}
+	}
+
+	option := PzOption{Name: name, Type: optionType, Value: value}
+	if i, ok := o.index[name]; ok {
+		o.options[i] = option
+		return
+	}
+	o.index[name] = len(o.options)
+	o.options = append(o.options, option)
+}
+
+func (o PzOptions) Get(name string) (PzOption, bool) {
+	if i, ok := o.index[name]; ok {
+		return o.options[i], true
+	}
+
+	return PzOption{}, false
+}
+
+// Int returns the value of an int option, 0 if it is missing
+func (o PzOptions) Int(name string) int {
+	option, _ := o.Get(name)
+	value, _ := option.Value.(int)
+	return value
+}
+
+func (o PzOptions) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, option := range o.options {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(option.Name)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		field.SetInt(int64(intVal))
-	case reflect.Float64:
-		floatVal, err := strconv.ParseFloat(value, 64)
+		value, err := json.Marshal(option.Value)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		field.SetFloat(floatVal)
-	case reflect.String:
-		field.SetString(value)
-	default:
-		return errors.New("unsupported field type")
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON keeps the order of the object, the types come from the schema or the JSON values
+func (o *PzOptions) UnmarshalJSON(data []byte) error {
+	options, _, err := decode_options(data)
+	if err != nil {
+		return err
 	}
+
+	*o = options
 	return nil
 }
 
+// decode_options decodes an object of options and returns the names of the options whose
+// value doesn't match the schema type, e.g. exported by a game version where the type
+// changed. They are kept with the type of their value, or dropped if it isn't a scalar
+func decode_options(data []byte) (PzOptions, []string, error) {
+	options := PzOptions{}
+	mismatched := []string{}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return PzOptions{}, nil, err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return PzOptions{}, nil, errors.New("options must be an object")
+	}
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return PzOptions{}, nil, err
+		}
+		name := token.(string)
+
+		var value any
+		if err := decoder.Decode(&value); err != nil {
+			return PzOptions{}, nil, err
+		}
+
+		optionType, ok := pzOptionTypes[name]
+		if !ok {
+			optionType = infer_json_option_type(value)
+		}
+		coerced, err := coerce_option_value(value, optionType)
+		if err != nil {
+			mismatched = append(mismatched, name)
+			optionType = infer_json_option_type(value)
+			coerced, err = coerce_option_value(value, optionType)
+			if err != nil {
+				continue
+			}
+		}
+		options.Set(name, optionType, coerced)
+	}
+
+	_, err = decoder.Token()
+	if err != nil {
+		return PzOptions{}, nil, err
+	}
+
+	return options, mismatched, nil
+}
+
+// infer_option_type guesses the type of an option missing from the schema by its showoptions value
+func infer_option_type(value string) string {
+	if value == "true" || value == "false" {
+		return OptionTypeBool
+	}
+	if _, err := strconv.Atoi(value); err == nil {
+		return OptionTypeInt
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return OptionTypeFloat
+	}
+
+	return OptionTypeString
+}
+
+func infer_json_option_type(value any) string {
+	switch value := value.(type) {
+	case bool:
+		return OptionTypeBool
+	case json.Number:
+		return infer_option_type(value.String())
+	default:
+		return OptionTypeString
+	}
+}
+
+// coerce_option_value converts a value to the option type, numbers from JSON lose the
+// difference between 1 and 1.0 so they are converted by the type
+func coerce_option_value(value any, optionType string) (any, error) {
+	var text string
+	switch value := value.(type) {
+	case bool:
+		text = strconv.FormatBool(value)
+	case string:
+		text = value
+	case json.Number:
+		text = value.String()
+	case int:
+		text = strconv.Itoa(value)
+	case float64:
+		text = strconv.FormatFloat(value, 'f', -1, 64)
+	case nil:
+		text = ""
+	default:
+		return nil, fmt.Errorf("unsupported value %v", value)
+	}
+
+	return parse_option_value(text, optionType)
+}
+
+func parse_option_value(value string, optionType string) (any, error) {
+	switch optionType {
+	case OptionTypeBool:
+		return strings.ToLower(value) == "true", nil
+	case OptionTypeInt:
+		return strconv.Atoi(value)
+	case OptionTypeFloat:
+		return strconv.ParseFloat(value, 64)
+	case OptionTypeString:
+		return value, nil
+	default:
+		return nil, errors.New("unsupported option type")
+	}
+}
+
+type OptionPair struct {
+	Name  string
+	Value string
+}
+
+var (
+	pzOptions       PzOptions
+	lastOptionsHash string
+)
+
 func hashString(input string) string {
 	hash := sha256.Sum256([]byte(input))
 	return hex.EncodeToString(hash[:])
 }
 
 func pzOptions_update() error {
-	res, err := conn.Execute("showoptions")
+	res, err := rcon_execute("showoptions")
 	if err != nil {
 		return fmt.Errorf("error getting options: %v", err)
 	}
@@ -201,12 +256,23 @@ func pzOptions_update() error {
 		return nil
 	}
 
+	// The first sync after connecting isn't a change
+	if lastOptionsHash != "" {
+		metrics_record_options_change()
+	}
 	lastOptionsHash = currentHash
 	lines := strings.Split(res, "\n")
 	updatedOptions := PzOptions{}
 
-	if err := parseOptions(lines, &updatedOptions); err != nil {
-		return fmt.Errorf("error parsing options: %v", err)
+	mismatched := parseOptions(lines, &updatedOptions)
+	for _, name := range mismatched {
+		option, _ := updatedOptions.Get(name)
+		runtime.LogWarningf(app.ctx, "Option %s doesn't match the schema type %s, inferred type %s", name, pzOptionTypes[name], option.Type)
+	}
+	for _, option := range updatedOptions.options {
+		if _, ok := pzOptionTypes[option.Name]; !ok {
+			runtime.LogDebugf(app.ctx, "Unknown option %s, inferred type %s", option.Name, option.Type)
+		}
 	}
 
 	pzOptions = updatedOptions
@@ -216,8 +282,10 @@ func pzOptions_update() error {
 	return nil
 }
 
-func parseOptions(lines []string, target *PzOptions) error {
-	v := reflect.ValueOf(target).Elem()
+// parseOptions reads the showoptions lines into target and returns the names of the options
+// whose value doesn't match the schema type, they are kept with an inferred type
+func parseOptions(lines []string, target *PzOptions) []string {
+	mismatched := []string{}
 
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
@@ -227,24 +295,28 @@ func parseOptions(lines []string, target *PzOptions) error {
 
 		parts := strings.SplitN(strings.TrimPrefix(line, "* "), "=", 2)
 		if len(parts) != 2 {
-			runtime.LogDebugf(app.ctx, "Invalid option: %s", line)
 			continue
 		}
 
 		fieldName, fieldValue := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
-		field := v.FieldByName(fieldName)
 
-		if !field.IsValid() {
-			runtime.LogDebugf(app.ctx, "Unknown option: %s", fieldName)
-			continue
+		// Options missing from the schema, e.g. of newer game versions, are kept with an inferred type
+		optionType, ok := pzOptionTypes[fieldName]
+		if !ok {
+			optionType = infer_option_type(fieldValue)
 		}
 
-		if err := setFieldValue(field, fieldValue); err != nil {
-			runtime.LogWarningf(app.ctx, "failed to set field %s: %v", fieldName, err)
+		value, err := parse_option_value(fieldValue, optionType)
+		if err != nil {
+			mismatched = append(mismatched, fieldName)
+			optionType = infer_option_type(fieldValue)
+			value, _ = parse_option_value(fieldValue, optionType)
 		}
+
+		target.Set(fieldName, optionType, value)
 	}
 
-	return nil
+	return mismatched
 }
 
 func (app *App) UpdatePzOptions(newOptions PzOptions, reloadOptions bool) bool {
@@ -297,18 +369,24 @@ func (app *App) UpdatePzOptions(newOptions PzOptions, reloadOptions bool) bool {
 func (app *App) diffOptions(newOptions PzOptions) []OptionPair {
 	var optionsToUpdate []OptionPair
 
-	newVal := reflect.ValueOf(newOptions)
-	oldVal := reflect.ValueOf(pzOptions)
+	for _, newOption := range newOptions.options {
+		oldOption, ok := pzOptions.Get(newOption.Name)
+		if !ok {
+			runtime.LogDebugf(app.ctx, "Skipping %s, the server doesn't have the option", newOption.Name)
+			continue
+		}
 
-	for i := 0; i < newVal.NumField(); i++ {
-		fieldName := newVal.Type().Field(i).Name
-		newField := newVal.Field(i).Interface()
-		oldField := oldVal.FieldByName(fieldName).Interface()
+		newField, err := coerce_option_value(newOption.Value, oldOption.Type)
+		if err != nil {
+			runtime.LogWarningf(app.ctx, "Skipping %s: %v", newOption.Name, err)
+			continue
+		}
+		oldField := oldOption.Value
 
 		if newField != oldField {
-			runtime.LogDebugf(app.ctx, "Updating %s from %v to %v", fieldName, oldField, newField)
+			runtime.LogDebugf(app.ctx, "Updating %s from %v to %v", newOption.Name, oldField, newField)
 			optionsToUpdate = append(optionsToUpdate, OptionPair{
-				Name:  fieldName,
+				Name:  newOption.Name,
 				Value: fmt.Sprintf("%v", newField),
 			})
 		}
@@ -328,7 +406,7 @@ func (app *App) applyOptions(options []OptionPair) int {
 		runtime.EventsEmit(app.ctx, "setProgress", float64(successCount)/float64(optionCount)*100)
 
 		command := fmt.Sprintf("changeoption %s \"%s\"", option.Name, option.Value)
-		res, err := conn.Execute(command)
+		res, err := rcon_execute(command)
 
 		if err == nil && isOptionUpdateSuccessful(option, res) {
 			successCount++
@@ -349,14 +427,14 @@ func isOptionUpdateSuccessful(option OptionPair, res string) bool {
 	}
 
 	// For floats, handle formatting differences
-	field := reflect.ValueOf(pzOptions).FieldByName(option.Name)
-	if !field.IsValid() {
+	field, ok := pzOptions.Get(option.Name)
+	if !ok {
 		runtime.LogErrorf(nil, "Invalid field name: %s", option.Name)
 		return false
 	}
 
-	switch field.Kind() {
-	case reflect.Float64:
+	switch field.Type {
+	case OptionTypeFloat:
 		value, err := strconv.ParseFloat(option.Value, 64)
 		if err != nil {
 			runtime.LogErrorf(nil, "Failed to parse float: %s", option.Value)
@@ -369,3 +447,14 @@ func isOptionUpdateSuccessful(option OptionPair, res string) bool {
 		return false
 	}
 }
+
+// GetPzOptionTypes returns the type of each option of the server, including the options
+// missing from the schema
+func (app *App) GetPzOptionTypes() map[string]string {
+	types := map[string]string{}
+	for _, option := range pzOptions.options {
+		types[option.Name] = option.Type
+	}
+
+	return types
+}
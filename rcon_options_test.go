@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func optionNames(options PzOptions) []string {
+	names := []string{}
+	for _, option := range options.options {
+		names = append(names, option.Name)
+	}
+	return names
+}
+
+func TestParseOptions(t *testing.T) {
+	lines := []string{
+		"List of Server Options:",
+		"* PVP=true",
+		"* MaxPlayers=32",
+		"* AntiCheatProtectionType2ThresholdMultiplier=3.0",
+		"* FutureIntOption=7",
+		"* FutureFloatOption=0.5",
+		"* FutureBoolOption=false",
+		"* FutureStringOption=hello=world",
+		"* PingLimit=unlimited",
+		"* InvalidLine",
+		"ServerWelcomeMessage=not an option line",
+	}
+
+	var options PzOptions
+	mismatched := parseOptions(lines, &options)
+
+	wantNames := []string{
+		"PVP",
+		"MaxPlayers",
+		"AntiCheatProtectionType2ThresholdMultiplier",
+		"FutureIntOption",
+		"FutureFloatOption",
+		"FutureBoolOption",
+		"FutureStringOption",
+		"PingLimit",
+	}
+	if names := optionNames(options); !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("names = %v, want %v", names, wantNames)
+	}
+
+	tests := []struct {
+		name      string
+		wantType  string
+		wantValue any
+	}{
+		{"PVP", OptionTypeBool, true},
+		{"MaxPlayers", OptionTypeInt, 32},
+		{"AntiCheatProtectionType2ThresholdMultiplier", OptionTypeFloat, 3.0},
+		{"FutureIntOption", OptionTypeInt, 7},
+		{"FutureFloatOption", OptionTypeFloat, 0.5},
+		{"FutureBoolOption", OptionTypeBool, false},
+		{"FutureStringOption", OptionTypeString, "hello=world"},
+		// Doesn't match the schema type, kept with the inferred type
+		{"PingLimit", OptionTypeString, "unlimited"},
+	}
+	for _, test := range tests {
+		option, ok := options.Get(test.name)
+		if !ok {
+			t.Errorf("%s missing", test.name)
+			continue
+		}
+		if option.Type != test.wantType || option.Value != test.wantValue {
+			t.Errorf("%s = %s %v, want %s %v", test.name, option.Type, option.Value, test.wantType, test.wantValue)
+		}
+	}
+
+	if !reflect.DeepEqual(mismatched, []string{"PingLimit"}) {
+		t.Errorf("mismatched = %v, want [PingLimit]", mismatched)
+	}
+}
+
+func TestPzOptionsJSONRoundTrip(t *testing.T) {
+	var options PzOptions
+	options.Set("MaxPlayers", OptionTypeInt, 32)
+	options.Set("PVP", OptionTypeBool, false)
+	options.Set("AntiCheatProtectionType2ThresholdMultiplier", OptionTypeFloat, 3.0)
+	options.Set("FutureOption", OptionTypeString, "value")
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"MaxPlayers":32,"PVP":false,"AntiCheatProtectionType2ThresholdMultiplier":3,"FutureOption":"value"}`
+	if string(data) != want {
+		t.Fatalf("marshal = %s, want %s", data, want)
+	}
+
+	var decoded PzOptions
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.options, options.options) {
+		t.Errorf("unmarshal = %v, want %v", decoded.options, options.options)
+	}
+}
+
+func TestDecodeOptionsMismatch(t *testing.T) {
+	data := []byte(`{"PVP":true,"MaxPlayers":"many","PingLimit":1.5,"Unsupported":[1,2],"Public":false}`)
+
+	options, mismatched, err := decode_options(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantNames := []string{"PVP", "MaxPlayers", "PingLimit", "Public"}
+	if names := optionNames(options); !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("names = %v, want %v", names, wantNames)
+	}
+	if option, _ := options.Get("MaxPlayers"); option.Type != OptionTypeString || option.Value != "many" {
+		t.Errorf("MaxPlayers = %s %v, want string many", option.Type, option.Value)
+	}
+	if option, _ := options.Get("PingLimit"); option.Type != OptionTypeFloat || option.Value != 1.5 {
+		t.Errorf("PingLimit = %s %v, want float 1.5", option.Type, option.Value)
+	}
+	if want := []string{"MaxPlayers", "PingLimit", "Unsupported"}; !reflect.DeepEqual(mismatched, want) {
+		t.Errorf("mismatched = %v, want %v", mismatched, want)
+	}
+
+	if _, _, err := decode_options([]byte(`[1,2]`)); err == nil {
+		t.Error("expected an error for a non-object")
+	}
+}
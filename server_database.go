@@ -0,0 +1,395 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+type ServerDatabaseUser struct {
+	Username       string `json:"username"`
+	AccessLevel    string `json:"accessLevel"`
+	Banned         bool   `json:"banned"`
+	SteamId        string `json:"steamId"`
+	DisplayName    string `json:"displayName"`
+	LastConnection string `json:"lastConnection"`
+}
+
+type BannedSteamId struct {
+	SteamId string `json:"steamId"`
+	Reason  string `json:"reason"`
+}
+
+type BannedIp struct {
+	Ip       string `json:"ip"`
+	Username string `json:"username"`
+	Reason   string `json:"reason"`
+}
+
+// ServerDatabase is the content of the dedicated server's Zomboid/db/<server>.db
+type ServerDatabase struct {
+	Source         string               `json:"source"` // Local path or ssh host:path
+	Users          []ServerDatabaseUser `json:"users"`
+	BannedSteamIds []BannedSteamId      `json:"bannedSteamIds"`
+	BannedIps      []BannedIp           `json:"bannedIps"`
+}
+
+const (
+	PlayerDifferenceNotInList     = "not_in_list"     // User is whitelisted but not in the player list
+	PlayerDifferenceNotInDatabase = "not_in_database" // Player is in the list but not whitelisted
+	PlayerDifferenceAccessLevel   = "access_level"
+	PlayerDifferenceBanned        = "banned"
+	PlayerDifferenceSteamId       = "steam_id"
+)
+
+type PlayerDifference struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Current  string `json:"current"`  // Value in the player list
+	Database string `json:"database"` // Value in the server database
+}
+
+type ServerDatabaseImport struct {
+	Database    ServerDatabase     `json:"database"`
+	Differences []PlayerDifference `json:"differences"`
+	Error       string             `json:"error"`
+}
+
+func sqlite_string(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func sqlite_bool(value interface{}) bool {
+	switch v := value.(type) {
+	case int64:
+		return v != 0
+	case string:
+		return strings.EqualFold(v, "true") || v == "1"
+	default:
+		return false
+	}
+}
+
+func normalize_access_level(accessLevel string) string {
+	accessLevel = strings.ToLower(strings.TrimSpace(accessLevel))
+	if accessLevel == "" || accessLevel == "none" {
+		return "player"
+	}
+
+	return accessLevel
+}
+
+// read_server_database reads the whitelist and bans, the ban tables are missing
+// from some server versions and are skipped then
+func read_server_database(db *sqliteDatabase) (ServerDatabase, error) {
+	database := ServerDatabase{
+		Users:          []ServerDatabaseUser{},
+		BannedSteamIds: []BannedSteamId{},
+		BannedIps:      []BannedIp{},
+	}
+
+	rows, err := db.table_rows("whitelist")
+	if err != nil {
+		return database, err
+	}
+
+	for _, row := range rows {
+		user := ServerDatabaseUser{
+			Username:       sqlite_string(row["username"]),
+			AccessLevel:    sqlite_string(row["accesslevel"]),
+			Banned:         sqlite_bool(row["banned"]),
+			SteamId:        sqlite_string(row["steamid"]),
+			DisplayName:    sqlite_string(row["displayname"]),
+			LastConnection: sqlite_string(row["lastconnection"]),
+		}
+
+		// Older servers only had the admin and moderator flags
+		if _, ok := row["accesslevel"]; !ok {
+			if sqlite_bool(row["admin"]) {
+				user.AccessLevel = "admin"
+			} else if sqlite_bool(row["moderator"]) {
+				user.AccessLevel = "moderator"
+			}
+		}
+		user.AccessLevel = normalize_access_level(user.AccessLevel)
+
+		if user.Username != "" {
+			database.Users = append(database.Users, user)
+		}
+	}
+
+	if rows, err := db.table_rows("bannedid"); err == nil {
+		for _, row := range rows {
+			database.BannedSteamIds = append(database.BannedSteamIds, BannedSteamId{
+				SteamId: sqlite_string(row["steamid"]),
+				Reason:  sqlite_string(row["reason"]),
+			})
+		}
+	}
+
+	if rows, err := db.table_rows("bannedip"); err == nil {
+		for _, row := range rows {
+			database.BannedIps = append(database.BannedIps, BannedIp{
+				Ip:       sqlite_string(row["ip"]),
+				Username: sqlite_string(row["username"]),
+				Reason:   sqlite_string(row["reason"]),
+			})
+		}
+	}
+
+	return database, nil
+}
+
+// database_user_banned tells if the user is banned by name or by Steam ID
+func database_user_banned(database ServerDatabase, user ServerDatabaseUser) bool {
+	if user.Banned {
+		return true
+	}
+
+	for _, banned := range database.BannedSteamIds {
+		if user.SteamId != "" && banned.SteamId == user.SteamId {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diff_server_database compares the database with the player list, must be called with connMutex held
+func diff_server_database(database ServerDatabase) []PlayerDifference {
+	differences := []PlayerDifference{}
+	inDatabase := make(map[string]bool)
+
+	for _, user := range database.Users {
+		inDatabase[user.Username] = true
+
+		player := find_player(user.Username)
+		if player == nil {
+			differences = append(differences, PlayerDifference{Name: user.Username, Kind: PlayerDifferenceNotInList, Database: user.AccessLevel})
+			continue
+		}
+
+		if normalize_access_level(player.AccessLevel) != user.AccessLevel {
+			differences = append(differences, PlayerDifference{
+				Name:     user.Username,
+				Kind:     PlayerDifferenceAccessLevel,
+				Current:  player.AccessLevel,
+				Database: user.AccessLevel,
+			})
+		}
+
+		if banned := database_user_banned(database, user); player.Banned != banned {
+			differences = append(differences, PlayerDifference{
+				Name:     user.Username,
+				Kind:     PlayerDifferenceBanned,
+				Current:  fmt.Sprintf("%t", player.Banned),
+				Database: fmt.Sprintf("%t", banned),
+			})
+		}
+
+		if user.SteamId != "" && player.SteamId != user.SteamId {
+			differences = append(differences, PlayerDifference{
+				Name:     user.Username,
+				Kind:     PlayerDifferenceSteamId,
+				Current:  player.SteamId,
+				Database: user.SteamId,
+			})
+		}
+	}
+
+	for _, player := range players {
+		if !inDatabase[player.Name] {
+			differences = append(differences, PlayerDifference{Name: player.Name, Kind: PlayerDifferenceNotInDatabase, Current: player.AccessLevel})
+		}
+	}
+
+	return differences
+}
+
+func (app *App) server_database_import(source string, db *sqliteDatabase) ServerDatabaseImport {
+	database, err := read_server_database(db)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error reading server database: "+err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.serverDatabase.error_reading",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return ServerDatabaseImport{Error: err.Error()}
+	}
+	database.Source = source
+	runtime.LogInfof(app.ctx, "Read %d users from server database %s", len(database.Users), source)
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	return ServerDatabaseImport{
+		Database:    database,
+		Differences: diff_server_database(database),
+	}
+}
+
+func (app *App) LoadServerDatabaseDialog() ServerDatabaseImport {
+	path, err := runtime.OpenFileDialog(app.ctx, runtime.OpenDialogOptions{
+		Title: "Load server database",
+		Filters: []runtime.FileFilter{
+			{
+				DisplayName: "SQLite database",
+				Pattern:     "*.db",
+			},
+		},
+	})
+
+	if path == "" {
+		runtime.LogInfo(app.ctx, "No path given, not loading the server database")
+		return ServerDatabaseImport{}
+	}
+
+	var db *sqliteDatabase
+	if err == nil {
+		db, err = open_sqlite(path)
+	}
+	if err != nil {
+		runtime.LogWarning(app.ctx, err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.serverDatabase.error_reading",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return ServerDatabaseImport{Error: err.Error()}
+	}
+
+	if file_exists(path + "-wal") {
+		runtime.LogWarning(app.ctx, "Server database has a write-ahead log, the latest changes may be missing until the server checkpoints it")
+	}
+
+	return app.server_database_import(path, db)
+}
+
+func shell_quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// FetchServerDatabase downloads the database over the SSH tunnel of the current
+// connection, e.g. from /home/pzuser/Zomboid/db/servertest.db
+func (app *App) FetchServerDatabase(remotePath string) ServerDatabaseImport {
+	tunnel := connectionCredentials.SSH
+	if tunnel == nil {
+		err := errors.New("fetching the server database needs a connection through an SSH tunnel")
+		app.SendNotification(Notification{
+			Title:   "rcon.serverDatabase.error_fetching",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return ServerDatabaseImport{Error: err.Error()}
+	}
+
+	data, err := func() ([]byte, error) {
+		client, agentConn, err := dial_ssh(*tunnel)
+		if err != nil {
+			return nil, err
+		}
+		defer client.Close()
+		if agentConn != nil {
+			defer agentConn.Close()
+		}
+
+		session, err := client.NewSession()
+		if err != nil {
+			return nil, err
+		}
+		defer session.Close()
+
+		return session.Output("cat -- " + shell_quote(remotePath))
+	}()
+
+	var db *sqliteDatabase
+	if err == nil {
+		db, err = parse_sqlite(data)
+	}
+	if err != nil {
+		runtime.LogError(app.ctx, "Error fetching server database: "+err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.serverDatabase.error_fetching",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return ServerDatabaseImport{Error: err.Error()}
+	}
+
+	// Keep a copy next to the other data of the server
+	err = os.WriteFile(filepath.Join(get_server_folder(), "server.db"), data, 0o600)
+	if err != nil {
+		runtime.LogWarning(app.ctx, "Error saving server database copy: "+err.Error())
+	}
+
+	return app.server_database_import(tunnel.Host+":"+remotePath, db)
+}
+
+// ImportServerDatabase adds the whitelisted users to the player list and takes their
+// access level, ban state and Steam ID from the database, returns the number of changed players
+func (app *App) ImportServerDatabase(database ServerDatabase) int {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	changed := 0
+	for _, user := range database.Users {
+		player := find_player(user.Username)
+		if player == nil {
+			players = append(players, Player{Name: user.Username})
+			player = &players[len(players)-1]
+		}
+
+		banned := database_user_banned(database, user)
+		steamId := player.SteamId
+		if user.SteamId != "" {
+			steamId = user.SteamId
+		}
+
+		if player.AccessLevel == user.AccessLevel && player.Banned == banned && player.SteamId == steamId {
+			continue
+		}
+
+		player.AccessLevel = user.AccessLevel
+		player.Banned = banned
+		player.SteamId = steamId
+		changed++
+	}
+
+	for _, banned := range database.BannedSteamIds {
+		if is_valid_steam_id(banned.SteamId) {
+			add_steam_id_ban(SteamIdBan{SteamId: banned.SteamId, Reason: banned.Reason})
+		}
+	}
+	err := steam_bans_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving Steam ID bans: "+err.Error())
+	}
+
+	if changed > 0 {
+		players_changed()
+	}
+	runtime.LogInfof(app.ctx, "Imported server database, %d players changed", changed)
+	app.SendNotification(Notification{
+		Title:   "rcon.serverDatabase.imported",
+		Variant: "success",
+		Parameters: map[string]string{
+			"n": fmt.Sprintf("%d", changed),
+		},
+	})
+
+	return changed
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"unicode/utf16"
+)
+
+// sqliteDatabase is a minimal read-only reader of the SQLite file format, enough
+// to read the tables of the dedicated server's database without cgo
+type sqliteDatabase struct {
+	data       []byte
+	pageSize   int
+	usableSize int
+	encoding   uint32 // 1 = UTF-8, 2 = UTF-16le, 3 = UTF-16be
+}
+
+const sqliteHeader = "SQLite format 3\x00"
+
+func open_sqlite(path string) (*sqliteDatabase, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return parse_sqlite(data)
+}
+
+func parse_sqlite(data []byte) (*sqliteDatabase, error) {
+	if len(data) < 100 || string(data[:16]) != sqliteHeader {
+		return nil, errors.New("not a SQLite database")
+	}
+
+	pageSize := int(binary.BigEndian.Uint16(data[16:18]))
+	if pageSize == 1 {
+		pageSize = 65536
+	}
+	if pageSize < 512 || pageSize&(pageSize-1) != 0 || len(data)%pageSize != 0 {
+		return nil, errors.New("invalid SQLite page size")
+	}
+
+	// The usable size of a page can't be less than 480 bytes
+	usableSize := pageSize - int(data[20])
+	if usableSize < 480 {
+		return nil, errors.New("invalid SQLite reserved space")
+	}
+
+	return &sqliteDatabase{
+		data:       data,
+		pageSize:   pageSize,
+		usableSize: usableSize,
+		encoding:   binary.BigEndian.Uint32(data[56:60]),
+	}, nil
+}
+
+func (db *sqliteDatabase) page(number uint32) ([]byte, error) {
+	start := int(number-1) * db.pageSize
+	if number == 0 || start+db.pageSize > len(db.data) {
+		return nil, fmt.Errorf("page %d out of range", number)
+	}
+
+	return db.data[start : start+db.pageSize], nil
+}
+
+// sqlite_varint decodes a big-endian variable length integer of 1-9 bytes
+func sqlite_varint(buf []byte) (int64, int) {
+	var value uint64
+	for i := 0; i < 9 && i < len(buf); i++ {
+		if i == 8 {
+			return int64(value<<8 | uint64(buf[i])), 9
+		}
+		value = value<<7 | uint64(buf[i]&0x7f)
+		if buf[i]&0x80 == 0 {
+			return int64(value), i + 1
+		}
+	}
+
+	return int64(value), len(buf)
+}
+
+// payload returns the full payload of a leaf cell, following overflow pages
+func (db *sqliteDatabase) payload(page []byte, offset int, payloadSize int64) ([]byte, error) {
+	// The size is read from the file, a payload can't be larger than the pages can hold
+	if payloadSize < 0 || payloadSize > int64(len(db.data)/db.pageSize)*int64(db.usableSize) {
+		return nil, fmt.Errorf("invalid payload size %d", payloadSize)
+	}
+	size := int(payloadSize)
+
+	maxLocal := db.usableSize - 35
+	if size <= maxLocal {
+		if offset+size > len(page) {
+			return nil, errors.New("cell out of page")
+		}
+		return page[offset : offset+size], nil
+	}
+
+	minLocal := (db.usableSize-12)*32/255 - 23
+	local := minLocal + (size-minLocal)%(db.usableSize-4)
+	if local > maxLocal {
+		local = minLocal
+	}
+	if offset+local+4 > len(page) {
+		return nil, errors.New("cell out of page")
+	}
+
+	payload := make([]byte, 0, size)
+	payload = append(payload, page[offset:offset+local]...)
+	next := binary.BigEndian.Uint32(page[offset+local:])
+
+	for len(payload) < size {
+		overflow, err := db.page(next)
+		if err != nil {
+			return nil, err
+		}
+		next = binary.BigEndian.Uint32(overflow)
+		chunk := min(size-len(payload), db.usableSize-4)
+		payload = append(payload, overflow[4:4+chunk]...)
+	}
+
+	return payload, nil
+}
+
+func (db *sqliteDatabase) text(buf []byte) string {
+	if db.encoding != 2 && db.encoding != 3 {
+		return string(buf)
+	}
+
+	units := make([]uint16, len(buf)/2)
+	for i := range units {
+		if db.encoding == 2 {
+			units[i] = binary.LittleEndian.Uint16(buf[i*2:])
+		} else {
+			units[i] = binary.BigEndian.Uint16(buf[i*2:])
+		}
+	}
+
+	return string(utf16.Decode(units))
+}
+
+// record decodes a record into its column values: nil, int64, float64, string or []byte
+func (db *sqliteDatabase) record(payload []byte) ([]interface{}, error) {
+	headerSize, n := sqlite_varint(payload)
+	if headerSize < int64(n) || headerSize > int64(len(payload)) {
+		return nil, errors.New("invalid record header")
+	}
+
+	var serialTypes []int64
+	for pos := n; pos < int(headerSize); {
+		serialType, n := sqlite_varint(payload[pos:headerSize])
+		serialTypes = append(serialTypes, serialType)
+		pos += n
+	}
+
+	values := make([]interface{}, 0, len(serialTypes))
+	body := payload[headerSize:]
+	for _, serialType := range serialTypes {
+		var size int
+		switch {
+		case serialType >= 12 && serialType%2 == 0:
+			size = int(serialType-12) / 2
+		case serialType >= 13:
+			size = int(serialType-13) / 2
+		case serialType >= 1 && serialType <= 4:
+			size = int(serialType)
+		case serialType == 5:
+			size = 6
+		case serialType == 6 || serialType == 7:
+			size = 8
+		}
+		if size > len(body) {
+			return nil, errors.New("record out of payload")
+		}
+		field := body[:size]
+		body = body[size:]
+
+		switch {
+		case serialType == 0:
+			values = append(values, nil)
+		case serialType >= 1 && serialType <= 6:
+			// Big-endian two's complement of 1, 2, 3, 4, 6 or 8 bytes
+			value := int64(int8(field[0]))
+			for _, b := range field[1:] {
+				value = value<<8 | int64(b)
+			}
+			values = append(values, value)
+		case serialType == 7:
+			values = append(values, math.Float64frombits(binary.BigEndian.Uint64(field)))
+		case serialType == 8:
+			values = append(values, int64(0))
+		case serialType == 9:
+			values = append(values, int64(1))
+		case serialType >= 12 && serialType%2 == 0:
+			values = append(values, append([]byte{}, field...))
+		case serialType >= 13:
+			values = append(values, db.text(field))
+		default:
+			return nil, fmt.Errorf("unsupported serial type %d", serialType)
+		}
+	}
+
+	return values, nil
+}
+
+// scan calls fn with the rowid and values of each row in the table b-tree rooted at root
+func (db *sqliteDatabase) scan(root uint32, fn func(rowid int64, values []interface{}) error) error {
+	visited := make(map[uint32]bool)
+
+	var walk func(number uint32) error
+	walk = func(number uint32) error {
+		if visited[number] {
+			return fmt.Errorf("page %d visited twice", number)
+		}
+		visited[number] = true
+
+		page, err := db.page(number)
+		if err != nil {
+			return err
+		}
+
+		headerStart := 0
+		if number == 1 {
+			headerStart = 100
+		}
+		header := page[headerStart:]
+		pageType := header[0]
+		cellCount := int(binary.BigEndian.Uint16(header[3:5]))
+
+		headerSize := 8
+		if pageType == 0x05 {
+			headerSize = 12
+		}
+		if headerSize+cellCount*2 > len(header) {
+			return fmt.Errorf("cell count %d out of page", cellCount)
+		}
+		pointers := header[headerSize:]
+
+		for i := 0; i < cellCount; i++ {
+			offset := int(binary.BigEndian.Uint16(pointers[i*2:]))
+			if offset >= len(page) {
+				return errors.New("cell pointer out of page")
+			}
+
+			switch pageType {
+			case 0x05: // Interior table page, cells point to the left child
+				if offset+4 > len(page) {
+					return errors.New("cell out of page")
+				}
+				if err := walk(binary.BigEndian.Uint32(page[offset:])); err != nil {
+					return err
+				}
+			case 0x0d: // Leaf table page
+				size, n := sqlite_varint(page[offset:])
+				rowid, m := sqlite_varint(page[offset+n:])
+				if n == 0 || m == 0 {
+					return errors.New("cell out of page")
+				}
+				payload, err := db.payload(page, offset+n+m, size)
+				if err != nil {
+					return err
+				}
+				values, err := db.record(payload)
+				if err != nil {
+					return err
+				}
+				if err := fn(rowid, values); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unexpected page type 0x%02x", pageType)
+			}
+		}
+
+		if pageType == 0x05 {
+			return walk(binary.BigEndian.Uint32(header[8:12]))
+		}
+
+		return nil
+	}
+
+	return walk(root)
+}
+
+// table_columns extracts the column names from a CREATE TABLE statement
+func table_columns(sql string) ([]string, int) {
+	start := strings.Index(sql, "(")
+	end := strings.LastIndex(sql, ")")
+	if start == -1 || end <= start {
+		return nil, -1
+	}
+
+	// Split on commas outside of parentheses
+	var definitions []string
+	depth, last := 0, start+1
+	for i := start + 1; i < end; i++ {
+		switch sql[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				definitions = append(definitions, sql[last:i])
+				last = i + 1
+			}
+		}
+	}
+	definitions = append(definitions, sql[last:end])
+
+	columns := []string{}
+	rowidColumn := -1
+	for _, definition := range definitions {
+		fields := strings.Fields(definition)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "CONSTRAINT":
+			continue
+		}
+
+		// An INTEGER PRIMARY KEY column is an alias of the rowid and stored as NULL
+		upper := strings.ToUpper(definition)
+		if len(fields) > 1 && strings.ToUpper(fields[1]) == "INTEGER" && strings.Contains(upper, "PRIMARY KEY") {
+			rowidColumn = len(columns)
+		}
+
+		columns = append(columns, strings.ToLower(strings.Trim(fields[0], "\"`[]")))
+	}
+
+	return columns, rowidColumn
+}
+
+// table_rows returns the rows of a table as maps of lower case column names to values
+func (db *sqliteDatabase) table_rows(table string) ([]map[string]interface{}, error) {
+	var root uint32
+	var sql string
+
+	err := db.scan(1, func(rowid int64, values []interface{}) error {
+		if len(values) < 5 || values[0] != "table" {
+			return nil
+		}
+		if name, ok := values[1].(string); ok && strings.EqualFold(name, table) {
+			rootPage, _ := values[3].(int64)
+			root = uint32(rootPage)
+			sql, _ = values[4].(string)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if root == 0 {
+		return nil, fmt.Errorf("table %s not found", table)
+	}
+
+	columns, rowidColumn := table_columns(sql)
+	rows := []map[string]interface{}{}
+	err = db.scan(root, func(rowid int64, values []interface{}) error {
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if i < len(values) {
+				row[column] = values[i]
+			}
+		}
+		if rowidColumn != -1 {
+			row[columns[rowidColumn]] = rowid
+		}
+		rows = append(rows, row)
+		return nil
+	})
+
+	return rows, err
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"encoding/binary"
+	"os"
+	"strings"
+	"testing"
+)
+
+func read_test_database(t testing.TB) []byte {
+	data, err := os.ReadFile("testdata/players.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestReadServerDatabase(t *testing.T) {
+	db, err := parse_sqlite(read_test_database(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	database, err := read_server_database(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(database.Users) != 32 {
+		t.Fatalf("got %d users, want 32", len(database.Users))
+	}
+	if user := database.Users[0]; user.Username != "admin" || user.AccessLevel != "admin" || user.SteamId != "76561198000000001" {
+		t.Errorf("unexpected first user %+v", user)
+	}
+	if user := database.Users[1]; user.Username != "bob" || !user.Banned {
+		t.Errorf("unexpected second user %+v", user)
+	}
+
+	// The display name of player5 spills onto an overflow page
+	if name := database.Users[7].DisplayName; name != "Long "+strings.Repeat("n", 700) {
+		t.Errorf("overflow payload read as %d bytes", len(name))
+	}
+
+	if len(database.BannedSteamIds) != 1 || database.BannedSteamIds[0].Reason != "griefing" {
+		t.Errorf("unexpected banned Steam IDs %+v", database.BannedSteamIds)
+	}
+}
+
+func TestReadCorruptServerDatabase(t *testing.T) {
+	const pageSize = 512
+
+	tests := []struct {
+		name   string
+		modify func(data []byte) []byte
+	}{
+		{"truncated", func(data []byte) []byte { return data[:len(data)-pageSize] }},
+		{"invalid page size", func(data []byte) []byte {
+			binary.BigEndian.PutUint16(data[16:], 600)
+			return data
+		}},
+		{"reserved space", func(data []byte) []byte {
+			data[20] = 200
+			return data
+		}},
+		{"cell count", func(data []byte) []byte {
+			binary.BigEndian.PutUint16(data[100+3:], 0xffff)
+			return data
+		}},
+		{"cell pointer", func(data []byte) []byte {
+			binary.BigEndian.PutUint16(data[100+8:], pageSize-2)
+			return data
+		}},
+		{"payload size", func(data []byte) []byte {
+			// A 9 byte varint of the largest size on the first cell of the schema table
+			offset := int(binary.BigEndian.Uint16(data[100+8:]))
+			copy(data[offset:], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f})
+			return data
+		}},
+		{"negative payload size", func(data []byte) []byte {
+			offset := int(binary.BigEndian.Uint16(data[100+8:]))
+			copy(data[offset:], []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+			return data
+		}},
+		{"page type", func(data []byte) []byte {
+			for number := 2; number*pageSize <= len(data); number++ {
+				data[(number-1)*pageSize] = 0x02
+			}
+			return data
+		}},
+		{"child page", func(data []byte) []byte {
+			for number := 2; number*pageSize <= len(data); number++ {
+				if page := data[(number-1)*pageSize:]; page[0] == 0x05 {
+					binary.BigEndian.PutUint32(page[8:], 0xffffffff)
+				}
+			}
+			return data
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data := test.modify(read_test_database(t))
+
+			db, err := parse_sqlite(data)
+			if err != nil {
+				return
+			}
+			if _, err := read_server_database(db); err == nil {
+				t.Error("corrupt database read without an error")
+			}
+		})
+	}
+}
+
+func FuzzReadServerDatabase(f *testing.F) {
+	data := read_test_database(f)
+	f.Add(data)
+	f.Add(data[:1024])
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		db, err := parse_sqlite(data)
+		if err != nil {
+			return
+		}
+		read_server_database(db)
+	})
+}
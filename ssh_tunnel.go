@@ -0,0 +1,303 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gorcon/rcon"
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const sshDialTimeout = 10 * time.Second
+const sshReconnectAttempts = 3
+
+// SSHTunnel describes an SSH jump host used to reach an RCON port that is only
+// exposed on the server's localhost
+type SSHTunnel struct {
+	Host           string `json:"host"`
+	Port           string `json:"port"`           // Defaults to 22
+	User           string `json:"user"`           // SSH user
+	KeyPath        string `json:"keyPath"`        // Private key file, empty to use the SSH agent
+	KnownHostsPath string `json:"knownHostsPath"` // Defaults to ~/.ssh/known_hosts
+}
+
+// sshTunnelConn is an open SSH tunnel with its local forward
+type sshTunnelConn struct {
+	client    *ssh.Client
+	listener  net.Listener
+	agentConn net.Conn // Connection to the SSH agent, nil when a key file is used
+}
+
+var errSSHReconnectCancelled = errors.New("SSH tunnel reconnect cancelled")
+
+// sshTunnel is the tunnel of the current connection, guarded by connMutex
+var sshTunnel *sshTunnelConn
+
+func (tunnel *sshTunnelConn) close() {
+	tunnel.listener.Close()
+	tunnel.client.Close()
+	if tunnel.agentConn != nil {
+		tunnel.agentConn.Close()
+	}
+}
+
+// ssh_auth_methods returns the auth methods of the tunnel and the SSH agent connection
+// they use, if any, which the caller must close
+func ssh_auth_methods(tunnel SSHTunnel) ([]ssh.AuthMethod, net.Conn, error) {
+	if tunnel.KeyPath != "" {
+		key, err := os.ReadFile(tunnel.KeyPath)
+		if err != nil {
+			return nil, nil, errors.New("Error reading SSH key: " + err.Error())
+		}
+
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, nil, errors.New("Error parsing SSH key: " + err.Error())
+		}
+
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, nil, nil
+	}
+
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return nil, nil, errors.New("no SSH key given and SSH_AUTH_SOCK is not set")
+	}
+
+	agentConn, err := net.Dial("unix", socket)
+	if err != nil {
+		return nil, nil, errors.New("Error connecting to SSH agent: " + err.Error())
+	}
+
+	return []ssh.AuthMethod{ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers)}, agentConn, nil
+}
+
+func ssh_host_key_callback(tunnel SSHTunnel) (ssh.HostKeyCallback, error) {
+	knownHostsPath := tunnel.KnownHostsPath
+	if knownHostsPath == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+		knownHostsPath = filepath.Join(home, ".ssh", "known_hosts")
+	}
+
+	callback, err := knownhosts.New(knownHostsPath)
+	if err != nil {
+		return nil, errors.New("Error reading known_hosts: " + err.Error())
+	}
+
+	return callback, nil
+}
+
+func dial_ssh(tunnel SSHTunnel) (*ssh.Client, net.Conn, error) {
+	hostKeyCallback, err := ssh_host_key_callback(tunnel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authMethods, agentConn, err := ssh_auth_methods(tunnel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	port := tunnel.Port
+	if port == "" {
+		port = "22"
+	}
+
+	client, err := ssh.Dial("tcp", net.JoinHostPort(tunnel.Host, port), &ssh.ClientConfig{
+		User:            tunnel.User,
+		Auth:            authMethods,
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	})
+	if err != nil {
+		if agentConn != nil {
+			agentConn.Close()
+		}
+		return nil, nil, err
+	}
+
+	return client, agentConn, nil
+}
+
+// dial_rcon opens the RCON connection, through an SSH tunnel if one is configured.
+// The tunnel is nil without SSH
+func dial_rcon(credentials Credentials) (*rcon.Conn, *sshTunnelConn, error) {
+	address := rcon_address(credentials)
+
+	if credentials.SSH == nil {
+		rconConn, err := rcon.Dial(address, credentials.Password)
+		return rconConn, nil, err
+	}
+
+	client, agentConn, err := dial_ssh(*credentials.SSH)
+	if err != nil {
+		return nil, nil, errors.New("Error connecting to SSH host: " + err.Error())
+	}
+
+	// SSH channels don't support deadlines, so RCON talks to a local forward instead
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		client.Close()
+		if agentConn != nil {
+			agentConn.Close()
+		}
+		return nil, nil, errors.New("Error opening SSH tunnel: " + err.Error())
+	}
+	tunnel := &sshTunnelConn{client: client, listener: listener, agentConn: agentConn}
+	go forward_ssh_tunnel(client, listener, address)
+
+	rconConn, err := rcon.Dial(listener.Addr().String(), credentials.Password)
+	if err != nil {
+		tunnel.close()
+		return nil, nil, err
+	}
+
+	return rconConn, tunnel, nil
+}
+
+// forward_ssh_tunnel pipes connections accepted on the local listener to the
+// RCON address on the far side of the SSH connection, like ssh -L
+func forward_ssh_tunnel(client *ssh.Client, listener net.Listener, address string) {
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		remote, err := client.Dial("tcp", address)
+		if err != nil {
+			runtime.LogError(app.ctx, "Error opening SSH tunnel: "+err.Error())
+			local.Close()
+			continue
+		}
+
+		go func() {
+			defer local.Close()
+			defer remote.Close()
+
+			done := make(chan struct{}, 2)
+			go func() {
+				io.Copy(remote, local)
+				done <- struct{}{}
+			}()
+			go func() {
+				io.Copy(local, remote)
+				done <- struct{}{}
+			}()
+			<-done
+		}()
+	}
+}
+
+// set_ssh_tunnel makes the tunnel the one of the current connection and watches it,
+// must be called with connMutex held
+func set_ssh_tunnel(tunnel *sshTunnelConn) {
+	sshTunnel = tunnel
+	if tunnel != nil {
+		go watch_ssh_tunnel(tunnel)
+	}
+}
+
+// watch_ssh_tunnel closes the RCON connection when the SSH tunnel drops so the
+// connection watcher notices and reconnects
+func watch_ssh_tunnel(tunnel *sshTunnelConn) {
+	err := tunnel.client.Wait()
+	tunnel.close()
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	if sshTunnel != tunnel {
+		return
+	}
+
+	if err != nil {
+		runtime.LogWarning(app.ctx, "SSH tunnel closed: "+err.Error())
+	} else {
+		runtime.LogWarning(app.ctx, "SSH tunnel closed")
+	}
+
+	sshTunnel = nil
+	if conn != nil {
+		conn.Close()
+	}
+}
+
+// close_ssh_tunnel must be called with connMutex held
+func close_ssh_tunnel() {
+	if sshTunnel == nil {
+		return
+	}
+
+	tunnel := sshTunnel
+	sshTunnel = nil
+	tunnel.close()
+}
+
+// redial_ssh_tunnel retries dial_rcon with a growing delay between the attempts,
+// it gives up early when stop is closed
+func redial_ssh_tunnel(credentials Credentials, stop <-chan struct{}) (*rcon.Conn, *sshTunnelConn, error) {
+	var err error
+	for attempt := 1; attempt <= sshReconnectAttempts; attempt++ {
+		var rconConn *rcon.Conn
+		var tunnel *sshTunnelConn
+		rconConn, tunnel, err = dial_rcon(credentials)
+		if err == nil {
+			return rconConn, tunnel, nil
+		}
+		if attempt == sshReconnectAttempts {
+			break
+		}
+
+		select {
+		case <-stop:
+			return nil, nil, errSSHReconnectCancelled
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+
+	return nil, nil, err
+}
+
+// reconnect_ssh_tunnel re-dials the tunnel and RCON, must be called with connMutex held.
+// The lock is released while dialing so other calls fail fast instead of waiting, and
+// errSSHReconnectCancelled is returned if the connection was closed in the meantime
+func reconnect_ssh_tunnel(stop <-chan struct{}) error {
+	close_ssh_tunnel()
+	if conn != nil {
+		conn.Close()
+		conn = nil
+	}
+	credentials := connectionCredentials
+
+	runtime.LogInfo(app.ctx, "Reconnecting SSH tunnel")
+	connMutex.Unlock()
+	rconConn, tunnel, err := redial_ssh_tunnel(credentials, stop)
+	connMutex.Lock()
+
+	select {
+	case <-stop:
+		if err == nil {
+			rconConn.Close()
+			tunnel.close()
+		}
+		return errSSHReconnectCancelled
+	default:
+	}
+	if err != nil {
+		return err
+	}
+
+	conn = rconConn
+	set_ssh_tunnel(tunnel)
+	return nil
+}
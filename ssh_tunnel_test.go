@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorcon/rcon"
+	"github.com/gorcon/rcon/rcontest"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const testRconPassword = "password"
+
+// testSSHServer is an in-process SSH server that only allows direct-tcpip channels, like
+// a jump host used with ssh -L
+type testSSHServer struct {
+	t          *testing.T
+	address    string
+	config     *ssh.ServerConfig
+	hostSigner ssh.Signer
+
+	mutex    sync.Mutex
+	listener net.Listener
+	conns    []net.Conn
+}
+
+func new_test_signer(t *testing.T) (ssh.Signer, ed25519.PrivateKey) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signer, key
+}
+
+func new_test_ssh_server(t *testing.T, authorizedKey ssh.PublicKey) *testSSHServer {
+	hostSigner, _ := new_test_signer(t)
+
+	server := &testSSHServer{
+		t:          t,
+		hostSigner: hostSigner,
+		config: &ssh.ServerConfig{
+			PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+				if meta.User() == "pz" && bytes.Equal(key.Marshal(), authorizedKey.Marshal()) {
+					return nil, nil
+				}
+				return nil, errors.New("unauthorized key")
+			},
+		},
+	}
+	server.config.AddHostKey(hostSigner)
+	server.start("127.0.0.1:0")
+	t.Cleanup(server.stop)
+
+	return server
+}
+
+func (server *testSSHServer) start(address string) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		server.t.Fatal(err)
+	}
+
+	server.mutex.Lock()
+	server.listener = listener
+	server.address = listener.Addr().String()
+	server.mutex.Unlock()
+
+	go func() {
+		for {
+			c, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			server.mutex.Lock()
+			server.conns = append(server.conns, c)
+			server.mutex.Unlock()
+
+			go server.serve(c)
+		}
+	}()
+}
+
+// stop closes the listener and drops every connection, like a restarting host
+func (server *testSSHServer) stop() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.listener != nil {
+		server.listener.Close()
+		server.listener = nil
+	}
+	for _, c := range server.conns {
+		c.Close()
+	}
+	server.conns = nil
+}
+
+func (server *testSSHServer) serve(c net.Conn) {
+	serverConn, channels, requests, err := ssh.NewServerConn(c, server.config)
+	if err != nil {
+		c.Close()
+		return
+	}
+	defer serverConn.Close()
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "direct-tcpip" {
+			newChannel.Reject(ssh.UnknownChannelType, "only direct-tcpip is allowed")
+			continue
+		}
+
+		var target struct {
+			DestAddr   string
+			DestPort   uint32
+			OriginAddr string
+			OriginPort uint32
+		}
+		if err := ssh.Unmarshal(newChannel.ExtraData(), &target); err != nil {
+			newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		remote, err := net.Dial("tcp", net.JoinHostPort(target.DestAddr, strconv.Itoa(int(target.DestPort))))
+		if err != nil {
+			newChannel.Reject(ssh.ConnectionFailed, err.Error())
+			continue
+		}
+
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			remote.Close()
+			continue
+		}
+		go ssh.DiscardRequests(channelRequests)
+
+		go func() {
+			defer channel.Close()
+			defer remote.Close()
+
+			done := make(chan struct{}, 2)
+			go func() {
+				io.Copy(remote, channel)
+				done <- struct{}{}
+			}()
+			go func() {
+				io.Copy(channel, remote)
+				done <- struct{}{}
+			}()
+			<-done
+		}()
+	}
+}
+
+func (server *testSSHServer) port() string {
+	_, port, _ := net.SplitHostPort(server.address)
+	return port
+}
+
+func new_test_rcon_server(t *testing.T) *rcontest.Server {
+	server := rcontest.NewServer(
+		rcontest.SetSettings(rcontest.Settings{Password: testRconPassword}),
+		rcontest.SetCommandHandler(func(c *rcontest.Context) {
+			rcon.NewPacket(rcon.SERVERDATA_RESPONSE_VALUE, c.Request().ID, "pong: "+c.Request().Body()).WriteTo(c.Conn())
+		}),
+	)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func write_test_key(t *testing.T, key ed25519.PrivateKey) string {
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(path, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func write_test_known_hosts(t *testing.T, address string, key ssh.PublicKey) string {
+	path := filepath.Join(t.TempDir(), "known_hosts")
+	if err := os.WriteFile(path, []byte(knownhosts.Line([]string{address}, key)+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+// new_test_tunnel starts an SSH server and an RCON server behind it and returns
+// credentials that reach RCON through the tunnel with a key file
+func new_test_tunnel(t *testing.T) (*testSSHServer, Credentials) {
+	clientSigner, clientKey := new_test_signer(t)
+	sshServer := new_test_ssh_server(t, clientSigner.PublicKey())
+	rconServer := new_test_rcon_server(t)
+
+	host, port, _ := net.SplitHostPort(rconServer.Addr())
+
+	return sshServer, Credentials{
+		IP:       host,
+		Port:     port,
+		Password: testRconPassword,
+		SSH: &SSHTunnel{
+			Host:           "127.0.0.1",
+			Port:           sshServer.port(),
+			User:           "pz",
+			KeyPath:        write_test_key(t, clientKey),
+			KnownHostsPath: write_test_known_hosts(t, sshServer.address, sshServer.hostSigner.PublicKey()),
+		},
+	}
+}
+
+func expect_rcon_response(t *testing.T, rconConn *rcon.Conn) {
+	t.Helper()
+
+	res, err := rconConn.Execute("players")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "pong: players" {
+		t.Fatalf("unexpected response %q", res)
+	}
+}
+
+func TestSSHTunnelKeyAuth(t *testing.T) {
+	_, credentials := new_test_tunnel(t)
+
+	rconConn, tunnel, err := dial_rcon(credentials)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tunnel.close()
+	defer rconConn.Close()
+
+	expect_rcon_response(t, rconConn)
+	if tunnel.agentConn != nil {
+		t.Error("agent connection opened with a key file")
+	}
+}
+
+func TestSSHTunnelWrongRconPassword(t *testing.T) {
+	_, credentials := new_test_tunnel(t)
+	credentials.Password = "wrong"
+
+	_, tunnel, err := dial_rcon(credentials)
+	if err == nil {
+		tunnel.close()
+		t.Fatal("connected with a wrong RCON password")
+	}
+	if !errors.Is(err, rcon.ErrAuthFailed) {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSSHTunnelUnauthorizedKey(t *testing.T) {
+	_, credentials := new_test_tunnel(t)
+
+	_, otherKey := new_test_signer(t)
+	credentials.SSH.KeyPath = write_test_key(t, otherKey)
+
+	_, tunnel, err := dial_rcon(credentials)
+	if err == nil {
+		tunnel.close()
+		t.Fatal("connected with an unauthorized key")
+	}
+	if !strings.Contains(err.Error(), "unable to authenticate") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSSHTunnelAgentAuth(t *testing.T) {
+	_, credentials := new_test_tunnel(t)
+
+	key, err := os.ReadFile(credentials.SSH.KeyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey, err := ssh.ParseRawPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyring := agent.NewKeyring()
+	if err := keyring.Add(agent.AddedKey{PrivateKey: privateKey}); err != nil {
+		t.Fatal(err)
+	}
+
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+
+	agentClosed := make(chan struct{}, 1)
+	go func() {
+		c, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		agent.ServeAgent(keyring, c)
+		agentClosed <- struct{}{}
+	}()
+
+	t.Setenv("SSH_AUTH_SOCK", socket)
+	credentials.SSH.KeyPath = ""
+
+	rconConn, tunnel, err := dial_rcon(credentials)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect_rcon_response(t, rconConn)
+	rconConn.Close()
+	tunnel.close()
+
+	select {
+	case <-agentClosed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent connection left open after the tunnel was closed")
+	}
+}
+
+func TestSSHTunnelKnownHostsMismatch(t *testing.T) {
+	sshServer, credentials := new_test_tunnel(t)
+
+	otherHostSigner, _ := new_test_signer(t)
+	credentials.SSH.KnownHostsPath = write_test_known_hosts(t, sshServer.address, otherHostSigner.PublicKey())
+
+	_, tunnel, err := dial_rcon(credentials)
+	if err == nil {
+		tunnel.close()
+		t.Fatal("connected to a host with a mismatching key")
+	}
+	if !strings.Contains(err.Error(), "key mismatch") {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestSSHTunnelReconnect(t *testing.T) {
+	sshServer, credentials := new_test_tunnel(t)
+
+	rconConn, tunnel, err := dial_rcon(credentials)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect_rcon_response(t, rconConn)
+
+	// The host drops every connection and comes back after the first attempt failed
+	sshServer.stop()
+	tunnel.client.Wait()
+	if _, err := rconConn.Execute("players"); err == nil {
+		t.Fatal("RCON still answers after the SSH host dropped")
+	}
+	rconConn.Close()
+	tunnel.close()
+
+	restarted := time.AfterFunc(500*time.Millisecond, func() { sshServer.start(sshServer.address) })
+	defer restarted.Stop()
+
+	rconConn, tunnel, err = redial_ssh_tunnel(credentials, make(chan struct{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tunnel.close()
+	defer rconConn.Close()
+
+	expect_rcon_response(t, rconConn)
+}
+
+func TestSSHTunnelReconnectCancelled(t *testing.T) {
+	sshServer, credentials := new_test_tunnel(t)
+	sshServer.stop()
+
+	stop := make(chan struct{})
+	time.AfterFunc(100*time.Millisecond, func() { close(stop) })
+
+	started := time.Now()
+	_, _, err := redial_ssh_tunnel(credentials, stop)
+	if !errors.Is(err, errSSHReconnectCancelled) {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if time.Since(started) > 900*time.Millisecond {
+		t.Errorf("cancelled reconnect took %s", time.Since(started))
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+const starterKitName = "Starter kit"
+
+// Times a failed starter kit is retried before it is given up
+const starterKitMaxAttempts = 5
+
+type StarterKit struct {
+	Enabled bool         `json:"enabled"`
+	Items   []ItemRecord `json:"items"`
+	Xp      []KitXp      `json:"xp"`
+	Message string       `json:"message"` // Server message sent after granting, {name} is replaced with the player
+}
+
+type StarterKitGrant struct {
+	Player  string   `json:"player"`
+	ResetId int      `json:"resetId"`
+	Time    int64    `json:"time"` // unix timestamp
+	Errors  []string `json:"errors"`
+}
+
+// starterKitPending holds the parts of the starter kit that failed, they are retried
+// until they are granted or the attempts run out
+type starterKitPending struct {
+	Kit      Kit `json:"kit"`
+	Attempts int `json:"attempts"`
+}
+
+// starterKitState is kept per server, Seen maps each username to the ResetID of the
+// server when the player was last checked, so a player gets the kit once per reset
+type starterKitState struct {
+	StarterKit
+	Seen    map[string]int               `json:"seen"`
+	Pending map[string]starterKitPending `json:"pending"`
+	Grants  []StarterKitGrant            `json:"grants"`
+}
+
+var starterKit starterKitState
+
+func starter_kit_init() error {
+	starterKit = starterKitState{
+		StarterKit: StarterKit{Items: []ItemRecord{}, Xp: []KitXp{}},
+		Seen:       map[string]int{},
+		Pending:    map[string]starterKitPending{},
+		Grants:     []StarterKitGrant{},
+	}
+
+	starterKitFilePath := filepath.Join(get_server_folder(), "starter_kit.json")
+	if !file_exists(starterKitFilePath) {
+		return nil
+	}
+
+	err := readJSON(starterKitFilePath, &starterKit)
+	if starterKit.Seen == nil {
+		starterKit.Seen = map[string]int{}
+	}
+	if starterKit.Pending == nil {
+		starterKit.Pending = map[string]starterKitPending{}
+	}
+	if err != nil {
+		return errors.New("Error reading starter kit file: " + err.Error())
+	}
+
+	return nil
+}
+
+func starter_kit_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "starter_kit.json"), starterKit)
+}
+
+// starter_kit_check grants the starter kit to online players not seen since the last
+// server reset and retries the failed parts, must be called with connMutex held
+func starter_kit_check() {
+	// The ResetID is unknown until the options are loaded
+	resetId := pzOptions.Int("ResetID")
+	if !starterKit.Enabled || resetId == 0 {
+		return
+	}
+
+	changed := false
+	for _, player := range players {
+		if !player.Online {
+			continue
+		}
+		name := player.Name
+
+		var kit Kit
+		attempts := 0
+		if seenResetId, ok := starterKit.Seen[name]; !ok || seenResetId != resetId {
+			kit = Kit{
+				Name:    starterKitName,
+				Items:   starterKit.Items,
+				Xp:      starterKit.Xp,
+				Message: starterKit.Message,
+			}
+		} else if pending, ok := starterKit.Pending[name]; ok {
+			kit = pending.Kit
+			attempts = pending.Attempts
+		} else {
+			continue
+		}
+		starterKit.Seen[name] = resetId
+		changed = true
+
+		granted, errs, failed := grant_kit(kit, name)
+		attempts++
+		starterKit.Grants = append(starterKit.Grants, StarterKitGrant{Player: name, ResetId: resetId, Time: time.Now().Unix(), Errors: errs})
+
+		// A player who joins during an RCON hiccup gets the missing parts on the next check
+		switch {
+		case len(errs) == 0:
+			delete(starterKit.Pending, name)
+		case attempts < starterKitMaxAttempts:
+			starterKit.Pending[name] = starterKitPending{Kit: failed, Attempts: attempts}
+			runtime.LogWarningf(app.ctx, "Error granting the starter kit to %s, retrying (attempt %d/%d): %s", name, attempts, starterKitMaxAttempts, strings.Join(errs, "; "))
+		default:
+			delete(starterKit.Pending, name)
+			runtime.LogWarningf(app.ctx, "Error granting the starter kit to %s: %s", name, strings.Join(errs, "; "))
+			app.SendNotification(Notification{
+				Title:   "rcon.starterKit.fail",
+				Message: errs[len(errs)-1],
+				Variant: "warning",
+				Parameters: map[string]string{
+					"name": name,
+				},
+			})
+		}
+		if granted {
+			runtime.LogInfof(app.ctx, "Granted the starter kit to %s", name)
+			app.SendNotification(Notification{
+				Title:   "rcon.starterKit.success",
+				Variant: "success",
+				Parameters: map[string]string{
+					"name": name,
+				},
+			})
+		}
+	}
+
+	if !changed {
+		return
+	}
+
+	if err := starter_kit_save(); err != nil {
+		runtime.LogError(app.ctx, "Error saving starter kit: "+err.Error())
+	}
+	if err := kit_grants_save(); err != nil {
+		runtime.LogError(app.ctx, "Error saving kit grants: "+err.Error())
+	}
+}
+
+func (app *App) GetStarterKit() StarterKit {
+	return starterKit.StarterKit
+}
+
+// SaveStarterKit saves the starter kit, returns an empty string on success or the error.
+// When the kit is enabled, the players already known are marked as seen so only new
+// players get it
+func (app *App) SaveStarterKit(kit StarterKit) string {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	// The kit is saved in the folder of the connected server
+	if conn == nil {
+		return errRconNotConnected
+	}
+
+	if kit.Items == nil {
+		kit.Items = []ItemRecord{}
+	}
+	if kit.Xp == nil {
+		kit.Xp = []KitXp{}
+	}
+	if kit.Enabled {
+		if err := validate_kit(Kit{Name: starterKitName, Items: kit.Items, Xp: kit.Xp, Message: kit.Message}); err != nil {
+			return err.Error()
+		}
+	}
+	if kit.Enabled && !starterKit.Enabled && pzOptions.Int("ResetID") != 0 {
+		for _, player := range players {
+			if _, ok := starterKit.Seen[player.Name]; !ok {
+				starterKit.Seen[player.Name] = pzOptions.Int("ResetID")
+			}
+		}
+	}
+	starterKit.StarterKit = kit
+
+	err := starter_kit_save()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error saving starter kit: "+err.Error())
+		return err.Error()
+	}
+	runtime.LogInfof(app.ctx, "Starter kit saved, enabled: %t", kit.Enabled)
+
+	return ""
+}
+
+// GetStarterKitGrants returns the log of starter kit grants, newest first
+func (app *App) GetStarterKitGrants() []StarterKitGrant {
+	grants := make([]StarterKitGrant, 0, len(starterKit.Grants))
+	for i := len(starterKit.Grants) - 1; i >= 0; i-- {
+		grants = append(grants, starterKit.Grants[i])
+	}
+
+	return grants
+}
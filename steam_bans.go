@@ -0,0 +1,261 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Range of SteamID64s of individual accounts in the public universe
+const (
+	minSteamId64 = 76561197960265728
+	maxSteamId64 = 76561202255233023
+)
+
+const (
+	BanTypeUsername = "username"
+	BanTypeSteamId  = "steamid"
+)
+
+type SteamIdBan struct {
+	SteamId string `json:"steamId"`
+	Name    string `json:"name"`    // Player the Steam ID belongs to, if known
+	Reason  string `json:"reason"`  // Only kept locally, banid has no reason
+	Created int64  `json:"created"` // unix timestamp, 0 if imported
+}
+
+// BanEntry is a username or Steam ID ban as shown in the ban manager
+type BanEntry struct {
+	Type    string `json:"type"` // username, steamid
+	Name    string `json:"name"`
+	SteamId string `json:"steamId"`
+	Reason  string `json:"reason"`
+	Created int64  `json:"created"`
+}
+
+var steamIdBans []SteamIdBan
+
+func is_valid_steam_id(steamId string) bool {
+	if len(steamId) != 17 {
+		return false
+	}
+
+	id, err := strconv.ParseUint(steamId, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	return id >= minSteamId64 && id <= maxSteamId64
+}
+
+func steam_bans_init() error {
+	steamIdBans = []SteamIdBan{}
+
+	bansFilePath := filepath.Join(get_server_folder(), "steam_bans.json")
+	if !file_exists(bansFilePath) {
+		return nil
+	}
+
+	err := readJSON(bansFilePath, &steamIdBans)
+	if err != nil {
+		steamIdBans = []SteamIdBan{}
+		return errors.New("Error reading Steam ID bans file: " + err.Error())
+	}
+
+	return nil
+}
+
+func steam_bans_save() error {
+	err := create_folder(get_server_folder())
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(filepath.Join(get_server_folder(), "steam_bans.json"), steamIdBans)
+}
+
+// player_by_steam_id must be called with connMutex held
+func player_by_steam_id(steamId string) *Player {
+	for i := range players {
+		if players[i].SteamId == steamId {
+			return &players[i]
+		}
+	}
+
+	return nil
+}
+
+// add_steam_id_ban must be called with connMutex held
+func add_steam_id_ban(ban SteamIdBan) {
+	if player := player_by_steam_id(ban.SteamId); player != nil {
+		ban.Name = player.Name
+	}
+
+	for i := range steamIdBans {
+		if steamIdBans[i].SteamId == ban.SteamId {
+			if ban.Reason != "" {
+				steamIdBans[i].Reason = ban.Reason
+			}
+			if ban.Name != "" {
+				steamIdBans[i].Name = ban.Name
+			}
+			return
+		}
+	}
+
+	steamIdBans = append(steamIdBans, ban)
+}
+
+// filter_steam_ids drops and reports invalid Steam IDs
+func filter_steam_ids(steamIds []string) []string {
+	valid := []string{}
+	for _, steamId := range steamIds {
+		steamId = strings.TrimSpace(steamId)
+		if is_valid_steam_id(steamId) {
+			valid = append(valid, steamId)
+			continue
+		}
+
+		runtime.LogWarningf(app.ctx, "Invalid Steam ID: %s", steamId)
+		app.SendNotification(Notification{
+			Title:   "rcon.banSteamIds.invalid_steam_id",
+			Variant: "warning",
+			Parameters: map[string]string{
+				"id": steamId,
+			},
+		})
+	}
+
+	return valid
+}
+
+func (app *App) BanSteamIds(steamIds []string, reason string) {
+	steamIds = filter_steam_ids(steamIds)
+	if len(steamIds) == 0 {
+		return
+	}
+
+	command := RCONCommand{
+		CommandTemplate: "banid {name}",
+		PlayerNames:     steamIds,
+		SuccessCheck: func(steamId string, response string) bool {
+			return response == fmt.Sprintf("SteamID %s is now banned", steamId)
+		},
+		ErrorCheck: func(steamId string, response string) bool {
+			return strings.HasPrefix(response, "Expected SteamID")
+		},
+		UpdateFunc: func(steamId string, response string) {
+			add_steam_id_ban(SteamIdBan{SteamId: steamId, Reason: reason, Created: time.Now().Unix()})
+			if player := player_by_steam_id(steamId); player != nil {
+				player.Banned = true
+				player.Online = false
+			}
+		},
+		EmitUpdatePlayers: true,
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.banSteamIds.all_success",
+			AllFail:       "rcon.banSteamIds.all_fail",
+			Partial:       "rcon.banSteamIds.partial",
+			SingleSuccess: "rcon.banSteamIds.single_success",
+			SingleFail:    "rcon.banSteamIds.single_fail",
+		},
+	}
+
+	if command.execute() > 0 {
+		err := steam_bans_save()
+		if err != nil {
+			runtime.LogError(app.ctx, "Error saving Steam ID bans: "+err.Error())
+		}
+	}
+}
+
+func (app *App) UnbanSteamIds(steamIds []string) {
+	steamIds = filter_steam_ids(steamIds)
+	if len(steamIds) == 0 {
+		return
+	}
+
+	command := RCONCommand{
+		CommandTemplate: "unbanid {name}",
+		PlayerNames:     steamIds,
+		SuccessCheck: func(steamId string, response string) bool {
+			return response == fmt.Sprintf("SteamID %s is now unbanned", steamId)
+		},
+		ErrorCheck: func(steamId string, response string) bool {
+			return strings.HasPrefix(response, "Expected SteamID")
+		},
+		UpdateFunc: func(steamId string, response string) {
+			steamIdBans = slices.DeleteFunc(steamIdBans, func(ban SteamIdBan) bool { return ban.SteamId == steamId })
+			if player := player_by_steam_id(steamId); player != nil {
+				player.Banned = false
+			}
+		},
+		EmitUpdatePlayers: true,
+		Notifications: RCONCommandNotifications{
+			AllSuccess:    "rcon.unbanSteamIds.all_success",
+			AllFail:       "rcon.unbanSteamIds.all_fail",
+			Partial:       "rcon.unbanSteamIds.partial",
+			SingleSuccess: "rcon.unbanSteamIds.single_success",
+			SingleFail:    "rcon.unbanSteamIds.single_fail",
+		},
+	}
+
+	if command.execute() > 0 {
+		err := steam_bans_save()
+		if err != nil {
+			runtime.LogError(app.ctx, "Error saving Steam ID bans: "+err.Error())
+		}
+	}
+}
+
+// SetPlayerSteamId stores the Steam ID of a player, an empty ID removes it
+func (app *App) SetPlayerSteamId(name string, steamId string) bool {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	steamId = strings.TrimSpace(steamId)
+	player := find_player(name)
+	if player == nil || (steamId != "" && !is_valid_steam_id(steamId)) {
+		return false
+	}
+
+	player.SteamId = steamId
+	players_changed()
+
+	return true
+}
+
+// GetBans returns the username and Steam ID bans together
+func (app *App) GetBans() []BanEntry {
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	bans := []BanEntry{}
+	for _, player := range players {
+		if player.Banned {
+			bans = append(bans, BanEntry{Type: BanTypeUsername, Name: player.Name, SteamId: player.SteamId})
+		}
+	}
+
+	for _, ban := range steamIdBans {
+		name := ban.Name
+		if player := player_by_steam_id(ban.SteamId); player != nil {
+			name = player.Name
+		}
+		bans = append(bans, BanEntry{
+			Type:    BanTypeSteamId,
+			Name:    name,
+			SteamId: ban.SteamId,
+			Reason:  ban.Reason,
+			Created: ban.Created,
+		})
+	}
+
+	return bans
+}
@@ -11,6 +11,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/wailsapp/wails/v2/pkg/runtime"
 
@@ -27,6 +29,32 @@ func writeJSON(path string, data interface{}) error {
 	return json.NewEncoder(file).Encode(data)
 }
 
+// writeJSONAtomic writes to a temporary file in the same folder and renames it over
+// the file, so a crash or a full disk never leaves a truncated file behind
+func writeJSONAtomic(path string, data interface{}, perm os.FileMode) error {
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	err = file.Chmod(perm)
+	if err == nil {
+		err = json.NewEncoder(file).Encode(data)
+	}
+	if err == nil {
+		err = file.Sync()
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
 func readJSON(path string, data interface{}) error {
 	file, err := os.Open(path)
 	if err != nil {
@@ -65,27 +93,73 @@ func generateKey(passphrase string) []byte {
 	return hash[:]
 }
 
+// Prefix of ciphertexts produced with authenticated encryption
+const gcmPrefix = "gcm:"
+
+func isLegacyCiphertext(encryptedText string) bool {
+	return !strings.HasPrefix(encryptedText, gcmPrefix)
+}
+
 func Encrypt(plaintext, key string) (string, error) {
-	aesKey := generateKey(key)
-	block, err := aes.NewCipher(aesKey)
+	gcm, err := newGCM(generateKey(key))
 	if err != nil {
 		return "", err
 	}
 
-	ciphertext := make([]byte, aes.BlockSize+len(plaintext))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 		return "", err
 	}
 
-	stream := cipher.NewCFBEncrypter(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], []byte(plaintext))
+	ciphertext := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
 
 	// Base64 encode the ciphertext
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
+	return gcmPrefix + base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
 func Decrypt(encryptedText, key string) (string, error) {
+	if isLegacyCiphertext(encryptedText) {
+		return "", errors.New("ciphertext is not authenticated")
+	}
+
+	gcm, err := newGCM(generateKey(key))
+	if err != nil {
+		return "", err
+	}
+
+	// Base64 decode the encrypted text
+	ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(encryptedText, gcmPrefix))
+	if err != nil {
+		return "", err
+	}
+
+	if len(ciphertext) < gcm.NonceSize() {
+		return "", errors.New("ciphertext too short")
+	}
+
+	nonce := ciphertext[:gcm.NonceSize()]
+	ciphertext = ciphertext[gcm.NonceSize():]
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("ciphertext was tampered with or the key is wrong")
+	}
+
+	return string(plaintext), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// decryptCFB decrypts values written by versions that used unauthenticated AES-CFB,
+// only used to migrate them once
+func decryptCFB(encryptedText, key string) (string, error) {
 	aesKey := generateKey(key)
 	block, err := aes.NewCipher(aesKey)
 	if err != nil {
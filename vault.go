@@ -0,0 +1,553 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	vaultVersion           = 1
+	vaultMinPasswordLength = 8
+	vaultKeyLength         = 32
+)
+
+var (
+	errVaultWrongPassword = errors.New("wrong master password")
+	errVaultTampered      = errors.New("vault was modified outside of pz-admin")
+	errVaultLocked        = errors.New("vault is locked")
+	errVaultEntryNotFound = errors.New("no saved credentials for the server")
+)
+
+type VaultKdf struct {
+	Salt    string `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"` // KiB
+	Threads uint8  `json:"threads"`
+}
+
+// VaultFile is the on-disk form of the vault
+type VaultFile struct {
+	Version  int      `json:"version"`
+	Kdf      VaultKdf `json:"kdf"`
+	Verifier string   `json:"verifier"` // Tells a wrong master password apart from a modified vault
+	Nonce    string   `json:"nonce"`
+	Data     string   `json:"data"` // AES-GCM sealed []VaultEntry
+}
+
+type VaultEntry struct {
+	IP       string     `json:"ip"`
+	Port     string     `json:"port"`
+	Password string     `json:"password"`
+	SSH      *SSHTunnel `json:"ssh,omitempty"`
+	LastUsed int64      `json:"lastUsed"` // unix timestamp
+}
+
+type VaultStatus struct {
+	Exists   bool `json:"exists"`
+	Unlocked bool `json:"unlocked"`
+}
+
+var (
+	vaultMutex    sync.Mutex
+	vaultKey      []byte
+	vaultKdf      VaultKdf
+	vaultVerifier string
+	vaultEntries  []VaultEntry
+	vaultTimer    *time.Timer
+)
+
+func vault_exists() bool {
+	return file_exists(vaultPath)
+}
+
+func new_vault_kdf() (VaultKdf, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return VaultKdf{}, err
+	}
+
+	return VaultKdf{
+		Salt:    base64.StdEncoding.EncodeToString(salt),
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}, nil
+}
+
+// derive_vault_key derives the encryption key and the password verifier with Argon2id
+func derive_vault_key(masterPassword string, kdf VaultKdf) ([]byte, string, error) {
+	salt, err := base64.StdEncoding.DecodeString(kdf.Salt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	derived := argon2.IDKey([]byte(masterPassword), salt, kdf.Time, kdf.Memory, kdf.Threads, vaultKeyLength*2)
+	verifier := sha256.Sum256(derived[vaultKeyLength:])
+
+	return derived[:vaultKeyLength], base64.StdEncoding.EncodeToString(verifier[:]), nil
+}
+
+// vault_additional_data binds the header to the ciphertext so it can't be altered either
+func vault_additional_data(file VaultFile) []byte {
+	return []byte(fmt.Sprintf("pz-admin-vault:%d:%s:%d:%d:%d:%s", file.Version, file.Kdf.Salt, file.Kdf.Time, file.Kdf.Memory, file.Kdf.Threads, file.Verifier))
+}
+
+func write_vault(key []byte, kdf VaultKdf, verifier string, entries []VaultEntry) error {
+	plaintext, err := json.Marshal(entries)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	file := VaultFile{
+		Version:  vaultVersion,
+		Kdf:      kdf,
+		Verifier: verifier,
+		Nonce:    base64.StdEncoding.EncodeToString(nonce),
+	}
+	file.Data = base64.StdEncoding.EncodeToString(gcm.Seal(nil, nonce, plaintext, vault_additional_data(file)))
+
+	return writeJSONAtomic(vaultPath, file, 0o600)
+}
+
+func open_vault(masterPassword string) ([]byte, VaultKdf, string, []VaultEntry, error) {
+	var file VaultFile
+	err := readJSON(vaultPath, &file)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, err
+	}
+
+	if file.Version != vaultVersion {
+		return nil, VaultKdf{}, "", nil, fmt.Errorf("unsupported vault version: %d", file.Version)
+	}
+
+	key, verifier, err := derive_vault_key(masterPassword, file.Kdf)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(verifier), []byte(file.Verifier)) != 1 {
+		return nil, VaultKdf{}, "", nil, errVaultWrongPassword
+	}
+
+	nonce, err := base64.StdEncoding.DecodeString(file.Nonce)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, errVaultTampered
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(file.Data)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, errVaultTampered
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, err
+	}
+	if len(nonce) != gcm.NonceSize() {
+		return nil, VaultKdf{}, "", nil, errVaultTampered
+	}
+
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, vault_additional_data(file))
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, errVaultTampered
+	}
+
+	var entries []VaultEntry
+	err = json.Unmarshal(plaintext, &entries)
+	if err != nil {
+		return nil, VaultKdf{}, "", nil, errVaultTampered
+	}
+
+	return key, file.Kdf, verifier, entries, nil
+}
+
+// set_vault_unlocked must be called with vaultMutex held
+func set_vault_unlocked(key []byte, kdf VaultKdf, verifier string, entries []VaultEntry) {
+	vaultKey = key
+	vaultKdf = kdf
+	vaultVerifier = verifier
+	vaultEntries = entries
+	if vaultEntries == nil {
+		vaultEntries = []VaultEntry{}
+	}
+	touch_vault()
+}
+
+// touch_vault restarts the idle timer, must be called with vaultMutex held
+func touch_vault() {
+	if vaultTimer != nil {
+		vaultTimer.Stop()
+		vaultTimer = nil
+	}
+
+	if *config.VaultIdleTimeout <= 0 {
+		return
+	}
+
+	vaultTimer = time.AfterFunc(time.Duration(*config.VaultIdleTimeout)*time.Minute, func() {
+		runtime.LogInfo(appContext, "Locking vault after idle timeout")
+		app.LockVault()
+	})
+}
+
+// save_vault writes the unlocked entries back to disk, must be called with vaultMutex held
+func save_vault() error {
+	if vaultKey == nil {
+		return errVaultLocked
+	}
+
+	return write_vault(vaultKey, vaultKdf, vaultVerifier, vaultEntries)
+}
+
+func vault_entry_matches(entry VaultEntry, credentials Credentials) bool {
+	if entry.IP != credentials.IP || entry.Port != credentials.Port {
+		return false
+	}
+	if entry.SSH == nil || credentials.SSH == nil {
+		return entry.SSH == credentials.SSH
+	}
+
+	return entry.SSH.Host == credentials.SSH.Host
+}
+
+func vault_save_credentials(credentials Credentials) error {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	if vaultKey == nil {
+		return errVaultLocked
+	}
+	touch_vault()
+
+	entry := VaultEntry{
+		IP:       credentials.IP,
+		Port:     credentials.Port,
+		Password: credentials.Password,
+		SSH:      credentials.SSH,
+		LastUsed: time.Now().Unix(),
+	}
+
+	replaced := false
+	for i := range vaultEntries {
+		if vault_entry_matches(vaultEntries[i], credentials) {
+			vaultEntries[i] = entry
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		vaultEntries = append(vaultEntries, entry)
+	}
+
+	return save_vault()
+}
+
+func vault_delete_credentials(credentials Credentials) error {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	if vaultKey == nil {
+		return errVaultLocked
+	}
+	touch_vault()
+
+	for i := range vaultEntries {
+		if vault_entry_matches(vaultEntries[i], credentials) {
+			vaultEntries = append(vaultEntries[:i], vaultEntries[i+1:]...)
+			return save_vault()
+		}
+	}
+
+	return errVaultEntryNotFound
+}
+
+// vault_last_used_credentials returns the most recently saved credentials
+func vault_last_used_credentials() (Credentials, error) {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	if vaultKey == nil {
+		return Credentials{}, errVaultLocked
+	}
+	touch_vault()
+
+	var last *VaultEntry
+	for i := range vaultEntries {
+		if last == nil || vaultEntries[i].LastUsed > last.LastUsed {
+			last = &vaultEntries[i]
+		}
+	}
+
+	if last == nil {
+		return Credentials{}, nil
+	}
+
+	return Credentials{IP: last.IP, Port: last.Port, Password: last.Password, SSH: last.SSH}, nil
+}
+
+func (app *App) GetVaultStatus() VaultStatus {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	return VaultStatus{
+		Exists:   vault_exists(),
+		Unlocked: vaultKey != nil,
+	}
+}
+
+// CreateVault creates the vault and moves the saved credentials into it
+func (app *App) CreateVault(masterPassword string) bool {
+	if vault_exists() {
+		runtime.LogWarning(app.ctx, "Vault already exists")
+		app.SendNotification(Notification{
+			Title:   "rcon.vault.already_exists",
+			Variant: "warning",
+		})
+		return false
+	}
+
+	if len(masterPassword) < vaultMinPasswordLength {
+		app.SendNotification(Notification{
+			Title:   "rcon.vault.password_too_short",
+			Variant: "error",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", vaultMinPasswordLength),
+			},
+		})
+		return false
+	}
+
+	// Migrate credentials saved in the keyring or credentials.json
+	var entries []VaultEntry
+	var stored StoredCredentials
+	if file_exists(credentialsPath) {
+		err := readJSON(credentialsPath, &stored)
+		if err == nil {
+			var credentials Credentials
+			if credentials_legacy(stored) {
+				credentials, err = load_legacy_credentials(stored)
+			} else {
+				credentials, err = load_credentials(stored)
+			}
+			if err == nil && credentials.Password != "" {
+				entries = append(entries, VaultEntry{
+					IP:       credentials.IP,
+					Port:     credentials.Port,
+					Password: credentials.Password,
+					SSH:      credentials.SSH,
+					LastUsed: time.Now().Unix(),
+				})
+			}
+		}
+		if err != nil {
+			runtime.LogWarning(app.ctx, "Error migrating saved credentials: "+err.Error())
+		}
+	}
+
+	kdf, err := new_vault_kdf()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error creating vault: "+err.Error())
+		return false
+	}
+
+	key, verifier, err := derive_vault_key(masterPassword, kdf)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error creating vault: "+err.Error())
+		return false
+	}
+
+	vaultMutex.Lock()
+	set_vault_unlocked(key, kdf, verifier, entries)
+	err = save_vault()
+	vaultMutex.Unlock()
+
+	if err != nil {
+		runtime.LogError(app.ctx, "Error creating vault: "+err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.vault.error_saving_vault",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return false
+	}
+
+	if len(entries) > 0 {
+		runtime.LogInfo(app.ctx, "Moved saved credentials into the vault")
+		if err := forget_credentials(stored); err != nil {
+			runtime.LogWarning(app.ctx, "Error removing credentials from keyring: "+err.Error())
+		}
+		if err := os.Remove(credentialsPath); err != nil {
+			runtime.LogWarning(app.ctx, "Error removing credentials file: "+err.Error())
+		}
+	}
+
+	runtime.EventsEmit(app.ctx, "vaultUnlocked")
+	app.SendNotification(Notification{
+		Title:   "rcon.vault.created",
+		Variant: "success",
+	})
+	return true
+}
+
+func (app *App) UnlockVault(masterPassword string) bool {
+	key, kdf, verifier, entries, err := open_vault(masterPassword)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error unlocking vault: "+err.Error())
+
+		title := "rcon.vault.error_unlocking_vault"
+		if errors.Is(err, errVaultWrongPassword) {
+			title = "rcon.vault.wrong_password"
+		} else if errors.Is(err, errVaultTampered) {
+			title = "rcon.vault.tampered"
+		}
+
+		app.SendNotification(Notification{
+			Title:   title,
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return false
+	}
+
+	vaultMutex.Lock()
+	set_vault_unlocked(key, kdf, verifier, entries)
+	vaultMutex.Unlock()
+
+	runtime.LogInfo(app.ctx, "Vault unlocked")
+	runtime.EventsEmit(app.ctx, "vaultUnlocked")
+	return true
+}
+
+func (app *App) LockVault() {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	if vaultTimer != nil {
+		vaultTimer.Stop()
+		vaultTimer = nil
+	}
+
+	if vaultKey == nil {
+		return
+	}
+
+	for i := range vaultKey {
+		vaultKey[i] = 0
+	}
+	vaultKey = nil
+	vaultEntries = nil
+	vaultVerifier = ""
+
+	runtime.LogInfo(app.ctx, "Vault locked")
+	runtime.EventsEmit(app.ctx, "vaultLocked")
+}
+
+func (app *App) ChangeVaultPassword(oldPassword string, newPassword string) bool {
+	if len(newPassword) < vaultMinPasswordLength {
+		app.SendNotification(Notification{
+			Title:   "rcon.vault.password_too_short",
+			Variant: "error",
+			Parameters: map[string]string{
+				"n": fmt.Sprintf("%d", vaultMinPasswordLength),
+			},
+		})
+		return false
+	}
+
+	_, _, _, entries, err := open_vault(oldPassword)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error changing vault password: "+err.Error())
+		title := "rcon.vault.error_unlocking_vault"
+		if errors.Is(err, errVaultWrongPassword) {
+			title = "rcon.vault.wrong_password"
+		} else if errors.Is(err, errVaultTampered) {
+			title = "rcon.vault.tampered"
+		}
+		app.SendNotification(Notification{
+			Title:   title,
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return false
+	}
+
+	kdf, err := new_vault_kdf()
+	if err != nil {
+		runtime.LogError(app.ctx, "Error changing vault password: "+err.Error())
+		return false
+	}
+
+	key, verifier, err := derive_vault_key(newPassword, kdf)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error changing vault password: "+err.Error())
+		return false
+	}
+
+	vaultMutex.Lock()
+	set_vault_unlocked(key, kdf, verifier, entries)
+	err = save_vault()
+	vaultMutex.Unlock()
+
+	if err != nil {
+		runtime.LogError(app.ctx, "Error changing vault password: "+err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.vault.error_saving_vault",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return false
+	}
+
+	app.SendNotification(Notification{
+		Title:   "rcon.vault.password_changed",
+		Variant: "success",
+	})
+	return true
+}
+
+func (app *App) GetVaultCredentials() []Credentials {
+	vaultMutex.Lock()
+	defer vaultMutex.Unlock()
+
+	if vaultKey == nil {
+		return []Credentials{}
+	}
+	touch_vault()
+
+	credentials := make([]Credentials, 0, len(vaultEntries))
+	for _, entry := range vaultEntries {
+		credentials = append(credentials, Credentials{IP: entry.IP, Port: entry.Port, Password: entry.Password, SSH: entry.SSH})
+	}
+
+	return credentials
+}
+
+func (app *App) DeleteVaultCredentials(credentials Credentials) bool {
+	err := vault_delete_credentials(credentials)
+	if err != nil {
+		runtime.LogError(app.ctx, "Error deleting credentials from vault: "+err.Error())
+		return false
+	}
+
+	return true
+}
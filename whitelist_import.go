@@ -0,0 +1,388 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/wailsapp/wails/v2/pkg/runtime"
+)
+
+// Letters and digits without look-alikes such as 0/O and 1/l/I, passwords are read off a screen
+const passwordAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+const minPasswordLength = 8
+
+type WhitelistEntry struct {
+	Username string `json:"username"`
+	Password string `json:"password"` // Empty to generate one
+}
+
+type WhitelistImportResult struct {
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	Generated bool   `json:"generated"` // Password was generated
+	Success   bool   `json:"success"`
+	Error     string `json:"error"`
+}
+
+func generate_password(length int) (string, error) {
+	length = max(length, minPasswordLength)
+	password := make([]byte, length)
+	alphabetSize := big.NewInt(int64(len(passwordAlphabet)))
+
+	for i := range password {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+// parse_whitelist_csv reads username[,password] rows, with an optional header row
+// and either comma or semicolon separators
+func parse_whitelist_csv(content []byte) ([]WhitelistEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	firstLine, _, _ := strings.Cut(string(content), "\n")
+	if strings.Contains(firstLine, ";") && !strings.Contains(firstLine, ",") {
+		reader.Comma = ';'
+	}
+
+	entries := []WhitelistEntry{}
+	for row := 0; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) == 0 {
+			continue
+		}
+
+		username := strings.TrimSpace(record[0])
+		if row == 0 && (strings.EqualFold(username, "username") || strings.EqualFold(username, "name")) {
+			continue
+		}
+
+		entry := WhitelistEntry{Username: username}
+		if len(record) > 1 {
+			entry.Password = strings.TrimSpace(record[1])
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parse_whitelist_json reads either a list of usernames or a list of entries
+func parse_whitelist_json(content []byte) ([]WhitelistEntry, error) {
+	var entries []WhitelistEntry
+	err := json.Unmarshal(content, &entries)
+	if err == nil {
+		return entries, nil
+	}
+
+	var usernames []string
+	if json.Unmarshal(content, &usernames) != nil {
+		return nil, err
+	}
+
+	entries = make([]WhitelistEntry, 0, len(usernames))
+	for _, username := range usernames {
+		entries = append(entries, WhitelistEntry{Username: username})
+	}
+
+	return entries, nil
+}
+
+func parse_whitelist_import(path string) ([]WhitelistEntry, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []WhitelistEntry
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		entries, err = parse_whitelist_json(content)
+	} else {
+		entries, err = parse_whitelist_csv(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop empty rows and duplicate usernames
+	seen := make(map[string]bool)
+	unique := make([]WhitelistEntry, 0, len(entries))
+	for _, entry := range entries {
+		entry.Username = strings.TrimSpace(entry.Username)
+		if entry.Username == "" || seen[strings.ToLower(entry.Username)] {
+			continue
+		}
+		seen[strings.ToLower(entry.Username)] = true
+		unique = append(unique, entry)
+	}
+
+	return unique, nil
+}
+
+func (app *App) LoadWhitelistImportDialog() []WhitelistEntry {
+	path, err := runtime.OpenFileDialog(app.ctx, runtime.OpenDialogOptions{
+		Title: "Import whitelist",
+		Filters: []runtime.FileFilter{
+			{
+				DisplayName: "CSV, JSON",
+				Pattern:     "*.csv;*.json",
+			},
+		},
+	})
+
+	if path == "" {
+		runtime.LogInfo(app.ctx, "No path given, not importing the whitelist")
+		return nil
+	}
+
+	if err == nil {
+		var entries []WhitelistEntry
+		entries, err = parse_whitelist_import(path)
+		if err == nil {
+			runtime.LogInfof(app.ctx, "Read %d whitelist entries from %s", len(entries), path)
+			return entries
+		}
+	}
+
+	runtime.LogWarning(app.ctx, err.Error())
+	app.SendNotification(Notification{
+		Title:   "rcon.importWhitelist.error_reading_file",
+		Message: err.Error(),
+		Variant: "error",
+	})
+
+	return nil
+}
+
+// ImportWhitelist adds the users to the whitelist, generating passwords of the given
+// length for entries without one, and returns the result of each user
+func (app *App) ImportWhitelist(entries []WhitelistEntry, passwordLength int) []WhitelistImportResult {
+	if len(entries) == 0 {
+		runtime.LogInfo(app.ctx, "No whitelist entries given, not importing")
+		return []WhitelistImportResult{}
+	}
+
+	connMutex.Lock()
+	defer connMutex.Unlock()
+
+	defer runtime.EventsEmit(app.ctx, "setProgress", 0)
+	runtime.EventsEmit(app.ctx, "setProgress", 10)
+
+	results := make([]WhitelistImportResult, 0, len(entries))
+	successCount := 0
+	var lastErr string
+
+	for i, entry := range entries {
+		runtime.EventsEmit(app.ctx, "setProgress", int(float64(i+1)/float64(len(entries))*100))
+
+		result := WhitelistImportResult{
+			Username: strings.TrimSpace(entry.Username),
+			Password: strings.TrimSpace(entry.Password),
+		}
+
+		err := func() error {
+			if result.Username == "" {
+				return errors.New("username is empty")
+			}
+			if strings.Contains(result.Username, "\"") || strings.Contains(result.Password, "\"") {
+				return errors.New("username and password can't contain quotes")
+			}
+			if conn == nil {
+				return errors.New("RCON is not connected")
+			}
+
+			if result.Password == "" {
+				password, err := generate_password(passwordLength)
+				if err != nil {
+					return err
+				}
+				result.Password = password
+				result.Generated = true
+			}
+
+			command := fmt.Sprintf("adduser \"%s\" \"%s\"", result.Username, result.Password)
+			res, err := rcon_execute(command)
+			if err != nil {
+				return err
+			}
+			if !strings.Contains(res, fmt.Sprintf("User %s created with the password ", result.Username)) {
+				return errors.New(res)
+			}
+
+			return nil
+		}()
+
+		if err != nil {
+			result.Error = err.Error()
+			lastErr = result.Error
+			runtime.LogWarningf(app.ctx, "Error adding %s to the whitelist: %s", result.Username, result.Error)
+		} else {
+			result.Success = true
+			successCount++
+
+			if find_player(result.Username) == nil {
+				players = append(players, Player{Name: result.Username, Online: false, AccessLevel: "player"})
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	if successCount > 0 {
+		err := players_save()
+		if err != nil {
+			runtime.LogError(app.ctx, err.Error())
+		}
+	}
+
+	runtime.EventsEmit(app.ctx, "setProgress", 100)
+	runtime.EventsEmit(app.ctx, "update-players", players)
+	runtime.LogInfof(app.ctx, "Whitelist import finished, %d of %d users added", successCount, len(entries))
+
+	switch successCount {
+	case len(entries):
+		app.SendNotification(Notification{
+			Title:   "rcon.importWhitelist.all_success",
+			Variant: "success",
+			Parameters: map[string]string{
+				"s": fmt.Sprintf("%d", successCount),
+			},
+		})
+	case 0:
+		app.SendNotification(Notification{
+			Title:   "rcon.importWhitelist.all_fail",
+			Message: lastErr,
+			Variant: "error",
+			Parameters: map[string]string{
+				"f": fmt.Sprintf("%d", len(entries)),
+			},
+		})
+	default:
+		app.SendNotification(Notification{
+			Title:   "rcon.importWhitelist.partial",
+			Message: lastErr,
+			Variant: "warning",
+			Parameters: map[string]string{
+				"s": fmt.Sprintf("%d", successCount),
+				"f": fmt.Sprintf("%d", len(entries)-successCount),
+			},
+		})
+	}
+
+	return results
+}
+
+func whitelist_credentials_csv(results []WhitelistImportResult) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	writer.Write([]string{"username", "password"})
+	for _, result := range results {
+		if result.Success {
+			writer.Write([]string{result.Username, result.Password})
+		}
+	}
+	writer.Flush()
+
+	return buf.Bytes(), writer.Error()
+}
+
+// write_credentials_zip writes the credentials into an AES encrypted zip
+func write_credentials_zip(path string, credentials []byte, password string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	archive := zip.NewWriter(file)
+	err = zip_create_aes(archive, "credentials.csv", credentials, password)
+	if err != nil {
+		return err
+	}
+
+	err = archive.Close()
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
+// ExportWhitelistCredentialsDialog saves the credentials of the added users as CSV,
+// inside a password protected zip if zipPassword is set
+func (app *App) ExportWhitelistCredentialsDialog(results []WhitelistImportResult, zipPassword string) {
+	defaultFilename, pattern, displayName := "credentials.csv", "*.csv", "CSV"
+	if zipPassword != "" {
+		defaultFilename, pattern, displayName = "credentials.zip", "*.zip", "ZIP"
+	}
+
+	path, err := runtime.SaveFileDialog(app.ctx, runtime.SaveDialogOptions{
+		Title:                "Export credentials",
+		DefaultFilename:      defaultFilename,
+		CanCreateDirectories: true,
+		Filters: []runtime.FileFilter{
+			{
+				DisplayName: displayName,
+				Pattern:     pattern,
+			},
+		},
+	})
+
+	if path == "" {
+		runtime.LogInfo(app.ctx, "No path given, not exporting the credentials")
+		return
+	}
+
+	if err == nil {
+		var credentials []byte
+		credentials, err = whitelist_credentials_csv(results)
+		if err == nil {
+			if zipPassword != "" {
+				err = write_credentials_zip(path, credentials, zipPassword)
+			} else {
+				err = os.WriteFile(path, credentials, 0o600)
+			}
+		}
+	}
+
+	if err != nil {
+		runtime.LogWarning(app.ctx, err.Error())
+		app.SendNotification(Notification{
+			Title:   "rcon.importWhitelist.error_exporting_credentials",
+			Message: err.Error(),
+			Variant: "error",
+		})
+		return
+	}
+
+	runtime.LogInfo(app.ctx, "Credentials exported to "+path)
+	app.SendNotification(Notification{
+		Title:   "rcon.importWhitelist.credentials_exported",
+		Path:    path,
+		Variant: "success",
+	})
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/aes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/binary"
+	"time"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// WinZip AES encryption (AE-2) as documented at https://www.winzip.com/en/support/aes-encryption/,
+// supported by 7-Zip, WinZip, the Windows 11 explorer and libarchive
+const (
+	zipMethodAES       = 99
+	zipAESExtraId      = 0x9901
+	zipAESVersion      = 2 // AE-2 leaves the CRC empty, the HMAC authenticates the data
+	zipAESStrength     = 3 // AES-256
+	zipAESKeySize      = 32
+	zipAESSaltSize     = 16
+	zipAESVerifierSize = 2
+	zipAESMacSize      = 10
+	zipAESIterations   = 1000
+	zipFlagEncrypted   = 0x1
+)
+
+// zip_aes_keys derives the encryption key, the HMAC key and the password verifier
+func zip_aes_keys(password string, salt []byte) (encryptionKey []byte, macKey []byte, verifier []byte) {
+	keys := pbkdf2.Key([]byte(password), salt, zipAESIterations, 2*zipAESKeySize+zipAESVerifierSize, sha1.New)
+
+	return keys[:zipAESKeySize], keys[zipAESKeySize : 2*zipAESKeySize], keys[2*zipAESKeySize:]
+}
+
+// zip_aes_ctr XORs data with AES in counter mode, WinZip counts little endian from 1
+// unlike cipher.NewCTR
+func zip_aes_ctr(key []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(data))
+	counter := make([]byte, aes.BlockSize)
+	keystream := make([]byte, aes.BlockSize)
+	for i := 0; i < len(data); i += aes.BlockSize {
+		binary.LittleEndian.PutUint64(counter, uint64(i/aes.BlockSize+1))
+		block.Encrypt(keystream, counter)
+
+		for j := i; j < min(i+aes.BlockSize, len(data)); j++ {
+			out[j] = data[j] ^ keystream[j-i]
+		}
+	}
+
+	return out, nil
+}
+
+// zip_create_aes adds a deflated file encrypted with the password to the archive
+func zip_create_aes(archive *zip.Writer, name string, content []byte, password string) error {
+	var compressed bytes.Buffer
+	compressor, err := flate.NewWriter(&compressed, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	_, err = compressor.Write(content)
+	if err != nil {
+		return err
+	}
+	err = compressor.Close()
+	if err != nil {
+		return err
+	}
+
+	salt := make([]byte, zipAESSaltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return err
+	}
+
+	encryptionKey, macKey, verifier := zip_aes_keys(password, salt)
+	encrypted, err := zip_aes_ctr(encryptionKey, compressed.Bytes())
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha1.New, macKey)
+	mac.Write(encrypted)
+
+	// Extra field with the AES version, vendor, strength and the actual compression method
+	extra := binary.LittleEndian.AppendUint16(nil, zipAESExtraId)
+	extra = binary.LittleEndian.AppendUint16(extra, 7)
+	extra = binary.LittleEndian.AppendUint16(extra, zipAESVersion)
+	extra = append(extra, 'A', 'E', zipAESStrength)
+	extra = binary.LittleEndian.AppendUint16(extra, zip.Deflate)
+
+	header := &zip.FileHeader{
+		Name:               name,
+		Method:             zipMethodAES,
+		Flags:              zipFlagEncrypted,
+		Extra:              extra,
+		CompressedSize64:   uint64(zipAESSaltSize + zipAESVerifierSize + len(encrypted) + zipAESMacSize),
+		UncompressedSize64: uint64(len(content)),
+	}
+	// CreateRaw only writes the MS-DOS time fields
+	header.SetModTime(time.Now())
+
+	writer, err := archive.CreateRaw(header)
+	if err != nil {
+		return err
+	}
+
+	for _, part := range [][]byte{salt, verifier, encrypted, mac.Sum(nil)[:zipAESMacSize]} {
+		_, err = writer.Write(part)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/flate"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+)
+
+// read_test_aes_zip decrypts the only file of an AE-2 archive and checks its authentication code
+func read_test_aes_zip(t *testing.T, data []byte, password string) ([]byte, bool) {
+	t.Helper()
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(archive.File) != 1 {
+		t.Fatalf("archive has %d files", len(archive.File))
+	}
+
+	file := archive.File[0]
+	if file.Method != zipMethodAES || file.Flags&zipFlagEncrypted == 0 {
+		t.Fatalf("file isn't AES encrypted, method %d flags %#x", file.Method, file.Flags)
+	}
+	extra := file.Extra
+	if binary.LittleEndian.Uint16(extra) != zipAESExtraId || binary.LittleEndian.Uint16(extra[4:]) != zipAESVersion ||
+		string(extra[6:8]) != "AE" || extra[8] != zipAESStrength || binary.LittleEndian.Uint16(extra[9:]) != zip.Deflate {
+		t.Fatalf("unexpected AES extra field %x", extra)
+	}
+
+	raw, err := file.OpenRaw()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := io.ReadAll(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	salt := payload[:zipAESSaltSize]
+	verifier := payload[zipAESSaltSize : zipAESSaltSize+zipAESVerifierSize]
+	encrypted := payload[zipAESSaltSize+zipAESVerifierSize : len(payload)-zipAESMacSize]
+	authCode := payload[len(payload)-zipAESMacSize:]
+
+	encryptionKey, macKey, expectedVerifier := zip_aes_keys(password, salt)
+	if !bytes.Equal(verifier, expectedVerifier) {
+		return nil, false
+	}
+
+	mac := hmac.New(sha1.New, macKey)
+	mac.Write(encrypted)
+	if !hmac.Equal(authCode, mac.Sum(nil)[:zipAESMacSize]) {
+		t.Fatal("authentication code mismatch")
+	}
+
+	compressed, err := zip_aes_ctr(encryptionKey, encrypted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return content, true
+}
+
+func TestZipCreateAES(t *testing.T) {
+	// Longer than a few AES blocks so the counter is incremented
+	content := []byte("username,password\n" + strings.Repeat("player,Passw0rd\n", 40))
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	if err := zip_create_aes(archive, "credentials.csv", content, "zip password"); err != nil {
+		t.Fatal(err)
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if bytes.Contains(buf.Bytes(), []byte("Passw0rd")) {
+		t.Fatal("archive contains the plain text")
+	}
+
+	decrypted, ok := read_test_aes_zip(t, buf.Bytes(), "zip password")
+	if !ok {
+		t.Fatal("password verifier mismatch")
+	}
+	if !bytes.Equal(decrypted, content) {
+		t.Errorf("decrypted %q", decrypted)
+	}
+
+	if _, ok := read_test_aes_zip(t, buf.Bytes(), "wrong password"); ok {
+		t.Error("wrong password accepted")
+	}
+}